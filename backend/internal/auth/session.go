@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/markbates/goth/gothic"
+)
+
+// Reads the signed in user's ID from the session cookie without responding, empty when there is
+// no signed in user
+func SessionUserID(r *http.Request) string {
+	session, err := gothic.Store.Get(r, SessionCookieName)
+	if err != nil {
+		return ""
+	}
+	userID, _ := session.Values[UserIDSessionKey].(string)
+	return userID
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Returned when a share token is malformed or its signature doesn't match
+var ErrInvalidShareToken = errors.New("invalid share token")
+
+// Signs a share link ID into the token spectators use, the ID followed by its signature. The
+// same link always gets the same token, so it can be shown again without being stored.
+func SignShareToken(secret, shareLinkID string) string {
+	return shareLinkID + "." + shareTokenSignature(secret, shareLinkID)
+}
+
+// Verifies a share token was signed with the secret and returns the share link ID in it.
+// Whether the link still exists and hasn't been revoked is up to the caller.
+func VerifyShareToken(secret, token string) (string, error) {
+	shareLinkID, signature, found := strings.Cut(token, ".")
+	if !found {
+		return "", ErrInvalidShareToken
+	}
+	if _, err := uuid.Parse(shareLinkID); err != nil {
+		return "", ErrInvalidShareToken
+	}
+	if !hmac.Equal([]byte(signature), []byte(shareTokenSignature(secret, shareLinkID))) {
+		return "", ErrInvalidShareToken
+	}
+	return shareLinkID, nil
+}
+
+func shareTokenSignature(secret, shareLinkID string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("share_link:" + shareLinkID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -27,6 +27,7 @@ type Config struct {
 	JWTSecret            string
 	SessionSecretKey     string
 	SessionEncryptionKey string
+	ShareTokenSecret     string
 	ProviderAuthConfig   ProvidersConfig
 	Log                  l.LogConfig
 }
@@ -68,7 +69,7 @@ func Load() (*Config, error) {
 	}
 
 	var err error
-	var dbUser, dbPassword, dbName, jwtSecret, sessionSecretKey, sessionEncryptionKey string
+	var dbUser, dbPassword, dbName, jwtSecret, sessionSecretKey, sessionEncryptionKey, shareTokenSecret string
 
 	dbUser, err = getRequiredEnv("POSTGRES_USER")
 	if err != nil {
@@ -94,6 +95,10 @@ func Load() (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	shareTokenSecret, err = getRequiredEnv("SHARE_TOKEN_SECRET")
+	if err != nil {
+		return nil, err
+	}
 
 	googleClientID := getEnv("GOOGLE_CLIENT_ID", "")
 	googleClientSecret := getEnv("GOOGLE_CLIENT_SECRET", "")
@@ -127,6 +132,7 @@ func Load() (*Config, error) {
 		JWTSecret:            jwtSecret,
 		SessionSecretKey:     sessionSecretKey,
 		SessionEncryptionKey: sessionEncryptionKey,
+		ShareTokenSecret:     shareTokenSecret,
 		ProviderAuthConfig: ProvidersConfig{
 			GoogleClientID:     googleClientID,
 			GoogleClientSecret: googleClientSecret,
@@ -0,0 +1,135 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	l "github.com/seankim658/skullking/internal/logger"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const asteriskComponent = "database-asterisk"
+
+const playerGameAsteriskColumns = `
+    player_game_asterisk_id, game_player_id, game_id, reason, created_at
+`
+
+// Marks a player's game with an asterisk, reason is optional
+func CreatePlayerGameAsterisk(
+	ctx context.Context,
+	tx *sql.Tx,
+	gameID, gamePlayerID string,
+	reason *string,
+) (*dbModels.PlayerGameAsterisk, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		asteriskComponent,
+		"CreatePlayerGameAsterisk",
+	).With().Str(l.GameIDKey, gameID).Str(l.GamePlayerIDKey, gamePlayerID).Logger()
+
+	query := `
+  INSERT INTO player_game_asterisks (
+    player_game_asterisk_id, game_player_id, game_id, reason, created_at
+  )
+  VALUES ($1, $2, $3, $4, $5)
+  RETURNING` + playerGameAsteriskColumns + ";"
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to create player game asterisk")
+
+	asterisk, err := scanPlayerGameAsterisk(querier.QueryRowContext(ctx, query,
+		uuid.NewString(),
+		gamePlayerID,
+		gameID,
+		reason,
+		time.Now(),
+	))
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to create player game asterisk")
+		return nil, err
+	}
+
+	logger.Info().Str(l.AsteriskIDKey, asterisk.PlayerGameAsteriskID).Msg("Player game asterisk created successfully")
+	return asterisk, nil
+}
+
+// Retrieves every asterisk recorded for a game, in the order they were recorded
+func GetPlayerGameAsterisksByGameID(ctx context.Context, tx *sql.Tx, gameID string) ([]dbModels.PlayerGameAsterisk, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		asteriskComponent,
+		"GetPlayerGameAsterisksByGameID",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT` + playerGameAsteriskColumns + `
+  FROM player_game_asterisks
+  WHERE game_id = $1
+  ORDER BY created_at, player_game_asterisk_id;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get asterisks for game")
+
+	rows, err := querier.QueryContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query asterisks for game")
+		return nil, fmt.Errorf("error querying asterisks for game %s: %w", gameID, err)
+	}
+	defer rows.Close()
+
+	var asterisks []dbModels.PlayerGameAsterisk
+	for rows.Next() {
+		asterisk, err := scanPlayerGameAsterisk(rows)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to scan player game asterisk row")
+			return nil, err
+		}
+		asterisks = append(asterisks, *asterisk)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over player game asterisk rows")
+		return nil, fmt.Errorf("error iterating asterisk rows for game %s: %w", gameID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(asterisks)).Msg("Asterisks for game retrieved successfully")
+	return asterisks, nil
+}
+
+// Removes an asterisk from a game
+func DeletePlayerGameAsterisk(ctx context.Context, tx *sql.Tx, gameID, asteriskID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		asteriskComponent,
+		"DeletePlayerGameAsterisk",
+	).With().Str(l.GameIDKey, gameID).Str(l.AsteriskIDKey, asteriskID).Logger()
+
+	query := `
+  DELETE FROM player_game_asterisks
+  WHERE player_game_asterisk_id = $1 AND game_id = $2;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to delete player game asterisk")
+
+	result, err := querier.ExecContext(ctx, query, asteriskID, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to delete player game asterisk")
+		return fmt.Errorf("error deleting asterisk %s: %w", asteriskID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after deleting player game asterisk")
+		return fmt.Errorf("error checking delete result for asterisk %s: %w", asteriskID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("No player game asterisk found to delete")
+		return ErrPlayerGameAsteriskNotFound
+	}
+
+	logger.Info().Msg("Player game asterisk deleted successfully")
+	return nil
+}
@@ -0,0 +1,137 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	l "github.com/seankim658/skullking/internal/logger"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const auditComponent = "database-audit"
+
+const scoreAuditColumns = `
+    a.audit_id, a.game_id, a.round_id, a.game_player_id, a.table_name, a.operation,
+    a.field_name, a.old_value, a.new_value, a.changed_by_user_id, a.changed_at
+`
+
+// A score audit log entry with the context needed to display it
+type ScoreAuditEntryDetail struct {
+	dbModels.ScoreAuditEntry
+	// Null once the round has been removed
+	RoundNumber sql.NullInt32
+	// Display name of the user who made the change, empty if unknown
+	ChangedByDisplayName string
+}
+
+// Sets the user the audit triggers attribute score changes in the transaction to. Only lasts
+// until the end of the transaction.
+func SetActingUser(ctx context.Context, tx *sql.Tx, userID string) error {
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		auditComponent,
+		"SetActingUser",
+	).With().Str(l.UserIDKey, userID).Logger()
+
+	if tx == nil {
+		logger.Error().Msg("Acting user can only be set inside a transaction")
+		return fmt.Errorf("error setting acting user %s: a transaction is required", userID)
+	}
+
+	query := "SELECT set_config('skullking.acting_user_id', $1, true);"
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to set acting user for audit log")
+
+	if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+		logger.Error().Err(err).Msg("Failed to set acting user")
+		return fmt.Errorf("error setting acting user %s: %w", userID, err)
+	}
+	return nil
+}
+
+// Retrieves the score audit log of a game, oldest change first
+func GetScoreAuditLogByGameID(ctx context.Context, tx *sql.Tx, gameID string) ([]ScoreAuditEntryDetail, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		auditComponent,
+		"GetScoreAuditLogByGameID",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT` + scoreAuditColumns + `,
+    r.round_number,
+    COALESCE(NULLIF(u.display_name, ''), u.username, '')
+  FROM score_audit_log a
+  LEFT JOIN rounds r ON r.round_id = a.round_id
+  LEFT JOIN users u ON u.user_id = a.changed_by_user_id
+  WHERE a.game_id = $1
+  ORDER BY a.changed_at, a.audit_id;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get score audit log for game")
+
+	rows, err := querier.QueryContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query score audit log")
+		return nil, fmt.Errorf("error querying score audit log for game %s: %w", gameID, err)
+	}
+	defer rows.Close()
+
+	var entries []ScoreAuditEntryDetail
+	for rows.Next() {
+		var entry ScoreAuditEntryDetail
+		if err := rows.Scan(
+			&entry.AuditID,
+			&entry.GameID,
+			&entry.RoundID,
+			&entry.GamePlayerID,
+			&entry.TableName,
+			&entry.Operation,
+			&entry.FieldName,
+			&entry.OldValue,
+			&entry.NewValue,
+			&entry.ChangedByUserID,
+			&entry.ChangedAt,
+			&entry.RoundNumber,
+			&entry.ChangedByDisplayName,
+		); err != nil {
+			logger.Error().Err(err).Msg("Failed to scan score audit log row")
+			return nil, fmt.Errorf("error scanning score audit log row for game %s: %w", gameID, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over score audit log rows")
+		return nil, fmt.Errorf("error iterating score audit log rows for game %s: %w", gameID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(entries)).Msg("Score audit log retrieved successfully")
+	return entries, nil
+}
+
+// Retrieves a single score audit log entry of a game
+func GetScoreAuditEntry(ctx context.Context, tx *sql.Tx, gameID, auditID string) (*dbModels.ScoreAuditEntry, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		auditComponent,
+		"GetScoreAuditEntry",
+	).With().Str(l.GameIDKey, gameID).Str(l.AuditIDKey, auditID).Logger()
+
+	query := `
+  SELECT` + scoreAuditColumns + `
+  FROM score_audit_log a
+  WHERE a.audit_id = $1 AND a.game_id = $2;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get score audit entry")
+
+	entry, err := scanScoreAuditEntry(querier.QueryRowContext(ctx, query, auditID, gameID))
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to get score audit entry")
+		return nil, err
+	}
+
+	logger.Info().Msg("Score audit entry retrieved successfully")
+	return entry, nil
+}
@@ -0,0 +1,212 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	l "github.com/seankim658/skullking/internal/logger"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const bonusComponent = "database-bonus"
+
+const roundBonusEventColumns = `
+    bonus_event_id, round_id, game_player_id, bonus_type, ally_game_player_id, created_at
+`
+
+// Records a bonus captured by a player during a round, allyGamePlayerID is only set for a
+// loot alliance
+func CreateRoundBonusEvent(
+	ctx context.Context,
+	tx *sql.Tx,
+	roundID, gamePlayerID, bonusType string,
+	allyGamePlayerID *string,
+) (*dbModels.RoundBonusEvent, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		bonusComponent,
+		"CreateRoundBonusEvent",
+	).With().
+		Str(l.RoundIDKey, roundID).
+		Str(l.GamePlayerIDKey, gamePlayerID).
+		Str(l.BonusTypeKey, bonusType).
+		Logger()
+
+	query := `
+  INSERT INTO round_bonus_events (
+    bonus_event_id, round_id, game_player_id, bonus_type, ally_game_player_id, created_at
+  )
+  VALUES ($1, $2, $3, $4, $5, $6)
+  RETURNING` + roundBonusEventColumns + ";"
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to create round bonus event")
+
+	event, err := scanRoundBonusEvent(querier.QueryRowContext(ctx, query,
+		uuid.NewString(),
+		roundID,
+		gamePlayerID,
+		bonusType,
+		allyGamePlayerID,
+		time.Now(),
+	))
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to create round bonus event")
+		return nil, err
+	}
+
+	logger.Info().Str(l.BonusEventIDKey, event.BonusEventID).Msg("Round bonus event created successfully")
+	return event, nil
+}
+
+// Retrieves every bonus event recorded for a round, in the order they were recorded
+func GetRoundBonusEventsByRoundID(ctx context.Context, tx *sql.Tx, roundID string) ([]dbModels.RoundBonusEvent, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		bonusComponent,
+		"GetRoundBonusEventsByRoundID",
+	).With().Str(l.RoundIDKey, roundID).Logger()
+
+	query := `
+  SELECT` + roundBonusEventColumns + `
+  FROM round_bonus_events
+  WHERE round_id = $1
+  ORDER BY created_at, bonus_event_id;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get bonus events for round")
+
+	rows, err := querier.QueryContext(ctx, query, roundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query bonus events for round")
+		return nil, fmt.Errorf("error querying bonus events for round %s: %w", roundID, err)
+	}
+	defer rows.Close()
+
+	var events []dbModels.RoundBonusEvent
+	for rows.Next() {
+		event, err := scanRoundBonusEvent(rows)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to scan round bonus event row")
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over round bonus event rows")
+		return nil, fmt.Errorf("error iterating bonus event rows for round %s: %w", roundID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(events)).Msg("Bonus events for round retrieved successfully")
+	return events, nil
+}
+
+// Retrieves every bonus event recorded in a game, in the order they were recorded
+func GetRoundBonusEventsByGameID(ctx context.Context, tx *sql.Tx, gameID string) ([]dbModels.RoundBonusEvent, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		bonusComponent,
+		"GetRoundBonusEventsByGameID",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT` + roundBonusEventColumns + `
+  FROM round_bonus_events
+  WHERE round_id IN (SELECT round_id FROM rounds WHERE game_id = $1)
+  ORDER BY created_at, bonus_event_id;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get bonus events for game")
+
+	rows, err := querier.QueryContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query bonus events for game")
+		return nil, fmt.Errorf("error querying bonus events for game %s: %w", gameID, err)
+	}
+	defer rows.Close()
+
+	var events []dbModels.RoundBonusEvent
+	for rows.Next() {
+		event, err := scanRoundBonusEvent(rows)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to scan round bonus event row")
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over round bonus event rows")
+		return nil, fmt.Errorf("error iterating bonus event rows for game %s: %w", gameID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(events)).Msg("Bonus events for game retrieved successfully")
+	return events, nil
+}
+
+// Deletes a bonus event from a round
+func DeleteRoundBonusEvent(ctx context.Context, tx *sql.Tx, roundID, bonusEventID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		bonusComponent,
+		"DeleteRoundBonusEvent",
+	).With().Str(l.RoundIDKey, roundID).Str(l.BonusEventIDKey, bonusEventID).Logger()
+
+	query := `
+  DELETE FROM round_bonus_events
+  WHERE bonus_event_id = $1 AND round_id = $2;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to delete round bonus event")
+
+	result, err := querier.ExecContext(ctx, query, bonusEventID, roundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to delete round bonus event")
+		return fmt.Errorf("error deleting bonus event %s: %w", bonusEventID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after deleting round bonus event")
+		return fmt.Errorf("error checking delete result for bonus event %s: %w", bonusEventID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("No round bonus event found to delete")
+		return ErrRoundBonusEventNotFound
+	}
+
+	logger.Info().Msg("Round bonus event deleted successfully")
+	return nil
+}
+
+// Deletes every bonus event recorded during a round
+func DeleteRoundBonusEventsByRoundID(ctx context.Context, tx *sql.Tx, roundID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		bonusComponent,
+		"DeleteRoundBonusEventsByRoundID",
+	).With().Str(l.RoundIDKey, roundID).Logger()
+
+	query := "DELETE FROM round_bonus_events WHERE round_id = $1;"
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to delete round bonus events")
+
+	result, err := querier.ExecContext(ctx, query, roundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to delete round bonus events")
+		return fmt.Errorf("error deleting bonus events for round %s: %w", roundID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after deleting round bonus events")
+		return fmt.Errorf("error checking rows affected for round %s bonus event deletion: %w", roundID, err)
+	}
+
+	logger.Info().Int64(l.CountKey, rowsAffected).Msg("Round bonus events deleted successfully")
+	return nil
+}
@@ -22,10 +22,13 @@ var (
   ErrFriendshipSelf = errors.New("cannot friend self")
 
 	// Game
-	ErrGameNotFound = errors.New("game not found")
+	ErrGameNotFound       = errors.New("game not found")
+	ErrGameStatusConflict = errors.New("game is not in the expected status")
+	ErrScorekeeperChanged = errors.New("game scorekeeper has changed")
 
 	// Session
-	ErrSessionNotFound = errors.New("game session not found")
+	ErrSessionNotFound       = errors.New("game session not found")
+	ErrSessionStatusConflict = errors.New("game session is not in the expected status")
 
 	// Guest player
 	ErrGuestPlayerNotFound = errors.New("guest player not found")
@@ -33,4 +36,38 @@ var (
 	// Game player
 	ErrGamePlayerNotFound  = errors.New("game player not found")
 	ErrPlayerAlreadyInGame = errors.New("player is already in this game")
+	ErrSeatTaken           = errors.New("seat is already taken in this game")
+
+	// Round
+	ErrRoundNotFound       = errors.New("round not found")
+	ErrRoundAlreadyExists  = errors.New("round already exists for this game")
+	ErrRoundStatusConflict = errors.New("round is not in the expected status")
+
+	// Player round score
+	ErrPlayerRoundScoreNotFound = errors.New("player round score not found")
+
+	// Round bonus event
+	ErrRoundBonusEventNotFound = errors.New("round bonus event not found")
+
+	// Score audit log
+	ErrScoreAuditEntryNotFound = errors.New("score audit entry not found")
+
+	// Player game asterisk
+	ErrPlayerGameAsteriskNotFound = errors.New("player game asterisk not found")
+
+	// Scorekeeper handoff
+	ErrScorekeeperHandoffNotFound = errors.New("scorekeeper handoff not found")
+	ErrScorekeeperHandoffResolved = errors.New("scorekeeper handoff has already been resolved")
+	ErrScorekeeperRequestPending  = errors.New("a scorekeeper request is already pending for this user")
+
+	// Sync action
+	ErrSyncActionNotFound      = errors.New("sync action not found")
+	ErrSyncActionAlreadyExists = errors.New("sync action has already been recorded for this game")
+
+	// Game join code
+	ErrJoinCodeNotFound = errors.New("join code not found")
+	ErrJoinCodeTaken    = errors.New("join code is already in use by another game")
+
+	// Share link
+	ErrShareLinkNotFound = errors.New("share link not found")
 )
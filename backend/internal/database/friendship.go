@@ -126,3 +126,32 @@ func GetFriendshipStatus(
 		)
 	}
 }
+
+// Checks whether a user is an accepted friend of any registered player in a game
+func IsFriendOfGamePlayer(ctx context.Context, tx *sql.Tx, gameID, userID string) (bool, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		friendshipComponent,
+		"IsFriendOfGamePlayer",
+	).With().Str(l.GameIDKey, gameID).Str(l.UserIDKey, userID).Logger()
+
+	query := `
+  SELECT EXISTS (
+    SELECT 1
+    FROM game_players gp
+    JOIN user_friendships uf
+      ON (uf.requester_id = gp.user_id AND uf.addressee_id = $2)
+      OR (uf.addressee_id = gp.user_id AND uf.requester_id = $2)
+    WHERE gp.game_id = $1 AND uf.status = 'accepted'
+  );
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to check if user is a friend of a game player")
+
+	var isFriend bool
+	if err := querier.QueryRowContext(ctx, query, gameID, userID).Scan(&isFriend); err != nil {
+		logger.Error().Err(err).Msg("Failed to check if user is a friend of a game player")
+		return false, fmt.Errorf("error checking if user %s is a friend of a player in game %s: %w", userID, gameID, err)
+	}
+	return isFriend, nil
+}
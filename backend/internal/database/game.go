@@ -11,10 +11,19 @@ import (
 
 	l "github.com/seankim658/skullking/internal/logger"
 	dbModels "github.com/seankim658/skullking/internal/models/database"
+	"github.com/seankim658/skullking/internal/scoring"
 )
 
 const gameComponent = "database-game"
 
+const gameColumns = `
+    game_id, session_id, created_by_user_id, current_scorekeeper_user_id,
+    status, starting_dealer_game_player_id, player_seating_order_randomized,
+    tiebreaker_rule, ruleset, kraken_enabled, white_whale_enabled, loot_enabled,
+    round_schedule, visibility, version, created_at, updated_at, started_at, completed_at,
+    abandoned_at, abandon_reason
+`
+
 // Inserts a new game into the games table
 func CreateGame(
 	ctx context.Context,
@@ -24,6 +33,10 @@ func CreateGame(
 	currentScorekeeperUserID,
 	initialStatus string,
 	playerSeatingOrderRandomized bool,
+	tiebreakerRule string,
+	ruleset scoring.Ruleset,
+	roundSchedule string,
+	visibility string,
 ) (string, error) {
 	querier := GetQuerier(tx)
 	logger := l.WithComponentAndSource(
@@ -41,9 +54,10 @@ func CreateGame(
 	query := `
   INSERT INTO games (
     game_id, session_id, created_by_user_id, current_scorekeeper_user_id, 
-    status, player_seating_order_randomized, created_at, updated_at
+    status, player_seating_order_randomized, tiebreaker_rule, ruleset, kraken_enabled,
+    white_whale_enabled, loot_enabled, round_schedule, visibility, created_at, updated_at
   )
-  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
   RETURNING game_id;
   `
 	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to create game")
@@ -61,6 +75,13 @@ func CreateGame(
 		NullString(currentScorekeeperUserID),
 		initialStatus,
 		playerSeatingOrderRandomized,
+		tiebreakerRule,
+		ruleset.Name,
+		ruleset.Kraken,
+		ruleset.WhiteWhale,
+		ruleset.Loot,
+		roundSchedule,
+		visibility,
 		currentTime,
 		currentTime,
 	).Scan(&returnedGameID)
@@ -115,8 +136,16 @@ func FindOrCreateGuestPlayer(ctx context.Context, tx *sql.Tx, displayName string
 	return guestPlayerID, nil
 }
 
-// Adds a registered user to a game
-func AddPlayerToGame(ctx context.Context, tx *sql.Tx, gameID string, userID, guestPlayerID *string, seatingOrder int) (string, error) {
+// Adds a registered user or guest to a game. `joinedWithCode` is set when the player added
+// themselves with the game's join code.
+func AddPlayerToGame(
+	ctx context.Context,
+	tx *sql.Tx,
+	gameID string,
+	userID, guestPlayerID *string,
+	seatingOrder int,
+	joinedWithCode bool,
+) (string, error) {
 	querier := GetQuerier(tx)
 	logger := l.WithComponentAndSource(
 		l.GetLoggerFromContext(ctx),
@@ -134,9 +163,9 @@ func AddPlayerToGame(ctx context.Context, tx *sql.Tx, gameID string, userID, gue
 
 	query := `
   INSERT INTO game_players (
-    game_player_id, game_id, user_id, guest_player_id, seating_order, final_score
+    game_player_id, game_id, user_id, guest_player_id, seating_order, final_score, joined_with_code
   )
-  VALUES ($1, $2, $3, $4, $5, $6)
+  VALUES ($1, $2, $3, $4, $5, $6, $7)
   RETURNING game_player_id;
   `
 	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to add player to game")
@@ -157,11 +186,13 @@ func AddPlayerToGame(ctx context.Context, tx *sql.Tx, gameID string, userID, gue
 		sqlGuestPlayerID,
 		seatingOrder,
 		0,
+		joinedWithCode,
 	).Scan(&returnedGamePlayerID)
 	if err != nil {
 		constraintMappings := map[string]error{
-			"uq_game_user":  ErrPlayerAlreadyInGame,
-			"uq_game_guest": ErrPlayerAlreadyInGame,
+			"uq_game_user":          ErrPlayerAlreadyInGame,
+			"uq_game_guest":         ErrPlayerAlreadyInGame,
+			"uq_game_seating_order": ErrSeatTaken,
 		}
 		handled, appErr := HandlePgError(err, logger, constraintMappings)
 		if handled {
@@ -185,10 +216,7 @@ func GetGameByID(ctx context.Context, tx *sql.Tx, gameID string) (*dbModels.Game
 	).With().Str(l.GameIDKey, gameID).Logger()
 
 	query := `
-  SELECT
-    game_id, session_id, created_by_user_id, current_scorekeeper_user_id, 
-    status, starting_dealer_game_player_id, player_seating_order_randomized, 
-    created_at, updated_at, completed_at
+  SELECT` + gameColumns + `
   FROM games
   WHERE game_id = $1;
   `
@@ -204,3 +232,649 @@ func GetGameByID(ctx context.Context, tx *sql.Tx, gameID string) (*dbModels.Game
 	logger.Info().Msg("Game retrieved successfully by ID")
 	return game, nil
 }
+
+// Retrieves all players in a game ordered by seating order
+func GetGamePlayersByGameID(ctx context.Context, tx *sql.Tx, gameID string) ([]dbModels.GamePlayer, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"GetGamePlayersByGameID",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT
+    game_player_id, game_id, user_id, guest_player_id, seating_order,
+    final_score, finishing_position, left_at, joined_at, joined_with_code
+  FROM game_players
+  WHERE game_id = $1
+  ORDER BY seating_order;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get players for game")
+
+	rows, err := querier.QueryContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query players for game")
+		return nil, fmt.Errorf("error querying players for game %s: %w", gameID, err)
+	}
+	defer rows.Close()
+
+	var players []dbModels.GamePlayer
+	for rows.Next() {
+		player, err := scanGamePlayer(rows)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to scan game player row")
+			return nil, err
+		}
+		players = append(players, *player)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over game player rows")
+		return nil, fmt.Errorf("error iterating player rows for game %s: %w", gameID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(players)).Msg("Players for game retrieved successfully")
+	return players, nil
+}
+
+// Helper struct to include a game player's resolved display name, taken from the user's
+// display name or username for registered players and from the guest record otherwise
+type GamePlayerDetail struct {
+	dbModels.GamePlayer
+	DisplayName string `db:"display_name"`
+}
+
+// Retrieves all players in a game with their display names, ordered by seating order
+func GetGamePlayerDetailsByGameID(ctx context.Context, tx *sql.Tx, gameID string) ([]GamePlayerDetail, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"GetGamePlayerDetailsByGameID",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT
+    gp.game_player_id, gp.game_id, gp.user_id, gp.guest_player_id, gp.seating_order,
+    gp.final_score, gp.finishing_position, gp.left_at, gp.joined_at, gp.joined_with_code,
+    COALESCE(NULLIF(u.display_name, ''), u.username, g.display_name, '') AS display_name
+  FROM game_players gp
+  LEFT JOIN users u ON gp.user_id = u.user_id
+  LEFT JOIN guest_players g ON gp.guest_player_id = g.guest_player_id
+  WHERE gp.game_id = $1
+  ORDER BY gp.seating_order;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get player details for game")
+
+	rows, err := querier.QueryContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query player details for game")
+		return nil, fmt.Errorf("error querying player details for game %s: %w", gameID, err)
+	}
+	defer rows.Close()
+
+	var players []GamePlayerDetail
+	for rows.Next() {
+		var p GamePlayerDetail
+		if err := rows.Scan(
+			&p.GamePlayerID,
+			&p.GameID,
+			&p.UserID,
+			&p.GuestPlayerID,
+			&p.SeatingOrder,
+			&p.FinalScore,
+			&p.FinishingPosition,
+			&p.LeftAt,
+			&p.JoinedAt,
+			&p.JoinedWithCode,
+			&p.DisplayName,
+		); err != nil {
+			logger.Error().Err(err).Msg("Failed to scan game player detail row")
+			return nil, fmt.Errorf("error scanning player detail row for game %s: %w", gameID, err)
+		}
+		players = append(players, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over game player detail rows")
+		return nil, fmt.Errorf("error iterating player detail rows for game %s: %w", gameID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(players)).Msg("Player details for game retrieved successfully")
+	return players, nil
+}
+
+// Rewrites the seating order of players in a game in a single statement, so seats can be
+// swapped without clashing part way through. Returns `ErrGamePlayerNotFound` if any player is
+// not in the game.
+func UpdateGamePlayerSeats(ctx context.Context, tx *sql.Tx, gameID string, seats map[string]int) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"UpdateGamePlayerSeats",
+	).With().Str(l.GameIDKey, gameID).Int(l.CountKey, len(seats)).Logger()
+
+	gamePlayerIDs := make([]string, 0, len(seats))
+	seatingOrders := make([]int, 0, len(seats))
+	for gamePlayerID, seatingOrder := range seats {
+		gamePlayerIDs = append(gamePlayerIDs, gamePlayerID)
+		seatingOrders = append(seatingOrders, seatingOrder)
+	}
+
+	query := `
+  UPDATE game_players gp
+  SET seating_order = s.seating_order
+  FROM unnest($2::uuid[], $3::int[]) AS s(game_player_id, seating_order)
+  WHERE gp.game_player_id = s.game_player_id AND gp.game_id = $1;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to update player seats")
+
+	result, err := querier.ExecContext(ctx, query, gameID, gamePlayerIDs, seatingOrders)
+	if err != nil {
+		constraintMappings := map[string]error{
+			"uq_game_seating_order": ErrSeatTaken,
+		}
+		handled, appErr := HandlePgError(err, logger, constraintMappings)
+		if handled {
+			return appErr
+		}
+		logger.Error().Err(err).Msg("Failed to update player seats")
+		return fmt.Errorf("error updating seats for game %s: %w", gameID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after updating seats")
+		return fmt.Errorf("error checking rows affected for game %s seating update: %w", gameID, err)
+	}
+	if rowsAffected != int64(len(seats)) {
+		logger.Warn().Int64("rows_affected", rowsAffected).Msg("Not every player to reseat was found in the game")
+		return ErrGamePlayerNotFound
+	}
+
+	logger.Info().Msg("Player seats updated successfully")
+	return nil
+}
+
+// Removes a player from a game and moves everyone seated after them up one seat, keeping the
+// seating order contiguous
+func RemoveGamePlayer(ctx context.Context, tx *sql.Tx, gameID, gamePlayerID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"RemoveGamePlayer",
+	).With().Str(l.GameIDKey, gameID).Str(l.GamePlayerIDKey, gamePlayerID).Logger()
+
+	deleteQuery := `
+  DELETE FROM game_players
+  WHERE game_player_id = $1 AND game_id = $2
+  RETURNING seating_order;
+  `
+	logger.Debug().Str(l.QueryKey, deleteQuery).Msg("Attempting to remove player from game")
+
+	var seatingOrder int
+	if err := querier.QueryRowContext(ctx, deleteQuery, gamePlayerID, gameID).Scan(&seatingOrder); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn().Msg("No player found in game to remove")
+			return ErrGamePlayerNotFound
+		}
+		logger.Error().Err(err).Msg("Failed to remove player from game")
+		return fmt.Errorf("error removing player %s from game %s: %w", gamePlayerID, gameID, err)
+	}
+
+	shiftQuery := `
+  UPDATE game_players
+  SET seating_order = seating_order - 1
+  WHERE game_id = $1 AND seating_order > $2;
+  `
+	logger.Debug().Str(l.QueryKey, shiftQuery).Msg("Attempting to close the gap in the seating order")
+
+	if _, err := querier.ExecContext(ctx, shiftQuery, gameID, seatingOrder); err != nil {
+		logger.Error().Err(err).Msg("Failed to close the gap in the seating order")
+		return fmt.Errorf("error reseating players after removing %s from game %s: %w", gamePlayerID, gameID, err)
+	}
+
+	logger.Info().Int(l.SeatingOrderKey, seatingOrder).Msg("Player removed from game successfully")
+	return nil
+}
+
+// Replaces the registered user or guest in a player's seat, keeping the seat itself
+func ReplaceGamePlayer(ctx context.Context, tx *sql.Tx, gameID, gamePlayerID string, userID, guestPlayerID *string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"ReplaceGamePlayer",
+	).With().Str(l.GameIDKey, gameID).Str(l.GamePlayerIDKey, gamePlayerID).Logger()
+
+	query := `
+  UPDATE game_players
+  SET user_id = $1, guest_player_id = $2
+  WHERE game_player_id = $3 AND game_id = $4;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to replace game player")
+
+	var sqlUserID, sqlGuestPlayerID sql.NullString
+	if userID != nil {
+		sqlUserID = NullString(*userID)
+	}
+	if guestPlayerID != nil {
+		sqlGuestPlayerID = NullString(*guestPlayerID)
+	}
+
+	result, err := querier.ExecContext(ctx, query, sqlUserID, sqlGuestPlayerID, gamePlayerID, gameID)
+	if err != nil {
+		constraintMappings := map[string]error{
+			"uq_game_user":  ErrPlayerAlreadyInGame,
+			"uq_game_guest": ErrPlayerAlreadyInGame,
+		}
+		handled, appErr := HandlePgError(err, logger, constraintMappings)
+		if handled {
+			return appErr
+		}
+		logger.Error().Err(err).Msg("Failed to replace game player")
+		return fmt.Errorf("error replacing player %s in game %s: %w", gamePlayerID, gameID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after replacing game player")
+		return fmt.Errorf("error checking rows affected for player %s replacement: %w", gamePlayerID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("No player found in game to replace")
+		return ErrGamePlayerNotFound
+	}
+
+	logger.Info().Msg("Game player replaced successfully")
+	return nil
+}
+
+// Moves a pending game to active with its starting dealer. Returns `ErrGameStatusConflict`
+// if the game is no longer pending.
+func StartGame(
+	ctx context.Context,
+	tx *sql.Tx,
+	gameID, startingDealerGamePlayerID string,
+	playerSeatingOrderRandomized bool,
+) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"StartGame",
+	).With().Str(l.GameIDKey, gameID).Str("starting_dealer_game_player_id", startingDealerGamePlayerID).Logger()
+
+	query := `
+  UPDATE games
+  SET status = 'active',
+    starting_dealer_game_player_id = $1,
+    player_seating_order_randomized = $2,
+    started_at = NOW(),
+    updated_at = NOW()
+  WHERE game_id = $3 AND status = 'pending';
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to start game")
+
+	result, err := querier.ExecContext(ctx, query, startingDealerGamePlayerID, playerSeatingOrderRandomized, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to start game")
+		return fmt.Errorf("error starting game %s: %w", gameID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after starting game")
+		return fmt.Errorf("error checking rows affected for game %s start: %w", gameID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("Game not found or no longer pending")
+		return ErrGameStatusConflict
+	}
+
+	logger.Info().Msg("Game started successfully")
+	return nil
+}
+
+// Marks a player as having left a game that is in progress
+func MarkGamePlayerLeft(ctx context.Context, tx *sql.Tx, gameID, gamePlayerID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"MarkGamePlayerLeft",
+	).With().Str(l.GameIDKey, gameID).Str(l.GamePlayerIDKey, gamePlayerID).Logger()
+
+	query := `
+  UPDATE game_players
+  SET left_at = NOW()
+  WHERE game_id = $1 AND game_player_id = $2 AND left_at IS NULL;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to mark player as left")
+
+	result, err := querier.ExecContext(ctx, query, gameID, gamePlayerID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to mark player as left")
+		return fmt.Errorf("error marking game player %s as left: %w", gamePlayerID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after marking player as left")
+		return fmt.Errorf("error checking rows affected for game player %s leaving: %w", gamePlayerID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("Player not found in game or already left")
+		return ErrGamePlayerNotFound
+	}
+
+	logger.Info().Msg("Player marked as left successfully")
+	return nil
+}
+
+// Sums the round scores of every player in a game, players without any scored rounds
+// have a total of zero. Tiebreaker rounds only decide the winner so they are excluded.
+func GetGamePlayerScoreTotals(ctx context.Context, tx *sql.Tx, gameID string) (map[string]int, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"GetGamePlayerScoreTotals",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT gp.game_player_id, COALESCE(SUM(prs.round_score), 0)
+  FROM game_players gp
+  LEFT JOIN (
+    player_round_scores prs
+    JOIN rounds r ON r.round_id = prs.round_id AND NOT r.is_tiebreaker_round
+  ) ON prs.game_player_id = gp.game_player_id
+  WHERE gp.game_id = $1
+  GROUP BY gp.game_player_id;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to sum player scores for game")
+
+	rows, err := querier.QueryContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query player score totals")
+		return nil, fmt.Errorf("error querying score totals for game %s: %w", gameID, err)
+	}
+	defer rows.Close()
+
+	totals := make(map[string]int)
+	for rows.Next() {
+		var gamePlayerID string
+		var total int
+		if err := rows.Scan(&gamePlayerID, &total); err != nil {
+			logger.Error().Err(err).Msg("Failed to scan player score total row")
+			return nil, fmt.Errorf("error scanning score total row for game %s: %w", gameID, err)
+		}
+		totals[gamePlayerID] = total
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over player score total rows")
+		return nil, fmt.Errorf("error iterating score total rows for game %s: %w", gameID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(totals)).Msg("Player score totals retrieved successfully")
+	return totals, nil
+}
+
+// Records a player's final score and finishing position
+func UpdateGamePlayerResult(ctx context.Context, tx *sql.Tx, gamePlayerID string, finalScore, finishingPosition int) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"UpdateGamePlayerResult",
+	).With().
+		Str(l.GamePlayerIDKey, gamePlayerID).
+		Int(l.FinalScoreKey, finalScore).
+		Int(l.FinishingPositionKey, finishingPosition).
+		Logger()
+
+	query := `
+  UPDATE game_players
+  SET final_score = $1, finishing_position = $2
+  WHERE game_player_id = $3;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to update player result")
+
+	result, err := querier.ExecContext(ctx, query, finalScore, finishingPosition, gamePlayerID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to update player result")
+		return fmt.Errorf("error updating result for game player %s: %w", gamePlayerID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after updating player result")
+		return fmt.Errorf("error checking rows affected for game player %s result update: %w", gamePlayerID, err)
+	}
+	if rowsAffected == 0 {
+		return ErrGamePlayerNotFound
+	}
+
+	logger.Info().Msg("Player result updated successfully")
+	return nil
+}
+
+// Moves an active game to completed and sets its completion time. Returns
+// `ErrGameStatusConflict` if the game is no longer active.
+func CompleteGame(ctx context.Context, tx *sql.Tx, gameID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"CompleteGame",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  UPDATE games
+  SET status = 'completed', completed_at = NOW(), updated_at = NOW()
+  WHERE game_id = $1 AND status = 'active';
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to complete game")
+
+	result, err := querier.ExecContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to complete game")
+		return fmt.Errorf("error completing game %s: %w", gameID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after completing game")
+		return fmt.Errorf("error checking rows affected for game %s completion: %w", gameID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("Game not found or no longer active")
+		return ErrGameStatusConflict
+	}
+
+	logger.Info().Msg("Game completed successfully")
+	return nil
+}
+
+// Reports whether a registered user is a player in a game
+func IsUserInGame(ctx context.Context, tx *sql.Tx, gameID, userID string) (bool, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"IsUserInGame",
+	).With().Str(l.GameIDKey, gameID).Str(l.UserIDKey, userID).Logger()
+
+	query := `
+  SELECT EXISTS (
+    SELECT 1 FROM game_players
+    WHERE game_id = $1 AND user_id = $2
+  );
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to check if user is in game")
+
+	var inGame bool
+	if err := querier.QueryRowContext(ctx, query, gameID, userID).Scan(&inGame); err != nil {
+		logger.Error().Err(err).Msg("Failed to check if user is in game")
+		return false, fmt.Errorf("error checking if user %s is in game %s: %w", userID, gameID, err)
+	}
+	return inGame, nil
+}
+
+// Moves a pending or active game to abandoned, recording when and optionally why. Returns
+// `ErrGameStatusConflict` if the game has already finished.
+func AbandonGame(ctx context.Context, tx *sql.Tx, gameID string, reason *string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"AbandonGame",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  UPDATE games
+  SET status = 'abandoned', abandoned_at = NOW(), abandon_reason = $2, updated_at = NOW()
+  WHERE game_id = $1 AND status IN ('pending', 'active');
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to abandon game")
+
+	result, err := querier.ExecContext(ctx, query, gameID, reason)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to abandon game")
+		return fmt.Errorf("error abandoning game %s: %w", gameID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after abandoning game")
+		return fmt.Errorf("error checking rows affected for game %s abandonment: %w", gameID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("Game not found or already finished")
+		return ErrGameStatusConflict
+	}
+
+	logger.Info().Msg("Game abandoned successfully")
+	return nil
+}
+
+// Abandons every pending or active game in a session, returning the IDs of the games abandoned
+func AbandonSessionGames(ctx context.Context, tx *sql.Tx, sessionID string, reason *string) ([]string, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"AbandonSessionGames",
+	).With().Str(l.SessionIDKey, sessionID).Logger()
+
+	query := `
+  UPDATE games
+  SET status = 'abandoned', abandoned_at = NOW(), abandon_reason = $2, updated_at = NOW()
+  WHERE session_id = $1 AND status IN ('pending', 'active')
+  RETURNING game_id;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to abandon session games")
+
+	rows, err := querier.QueryContext(ctx, query, sessionID, reason)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to abandon session games")
+		return nil, fmt.Errorf("error abandoning games for session %s: %w", sessionID, err)
+	}
+	defer rows.Close()
+
+	var gameIDs []string
+	for rows.Next() {
+		var gameID string
+		if err := rows.Scan(&gameID); err != nil {
+			logger.Error().Err(err).Msg("Failed to scan abandoned game ID")
+			return nil, fmt.Errorf("error scanning abandoned game for session %s: %w", sessionID, err)
+		}
+		gameIDs = append(gameIDs, gameID)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over abandoned game rows")
+		return nil, fmt.Errorf("error iterating abandoned games for session %s: %w", sessionID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(gameIDs)).Msg("Session games abandoned successfully")
+	return gameIDs, nil
+}
+
+// Retrieves every game in a session, oldest first
+func GetGamesBySessionID(ctx context.Context, tx *sql.Tx, sessionID string) ([]dbModels.Game, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"GetGamesBySessionID",
+	).With().Str(l.SessionIDKey, sessionID).Logger()
+
+	query := `
+  SELECT` + gameColumns + `
+  FROM games
+  WHERE session_id = $1
+  ORDER BY created_at;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get games for session")
+
+	rows, err := querier.QueryContext(ctx, query, sessionID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query games for session")
+		return nil, fmt.Errorf("error querying games for session %s: %w", sessionID, err)
+	}
+	defer rows.Close()
+
+	var sessionGames []dbModels.Game
+	for rows.Next() {
+		game, err := scanGame(rows)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to scan game row")
+			return nil, err
+		}
+		sessionGames = append(sessionGames, *game)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over game rows")
+		return nil, fmt.Errorf("error iterating games for session %s: %w", sessionID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(sessionGames)).Msg("Games for session retrieved successfully")
+	return sessionGames, nil
+}
+
+// Locks a game row for the rest of the transaction and returns its current version, so the
+// version can be compared before writing without another request changing it in between.
+// Returns `ErrGameNotFound` if the game does not exist.
+func LockGameVersion(ctx context.Context, tx *sql.Tx, gameID string) (int, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameComponent,
+		"LockGameVersion",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT version
+  FROM games
+  WHERE game_id = $1
+  FOR UPDATE;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to lock game version")
+
+	var version int
+	if err := querier.QueryRowContext(ctx, query, gameID).Scan(&version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn().Msg("Game not found to lock")
+			return 0, ErrGameNotFound
+		}
+		logger.Error().Err(err).Msg("Failed to lock game version")
+		return 0, fmt.Errorf("error locking version of game %s: %w", gameID, err)
+	}
+	return version, nil
+}
@@ -0,0 +1,155 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	l "github.com/seankim658/skullking/internal/logger"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const joinCodeComponent = "database-join-code"
+
+const gameJoinCodeColumns = `
+    game_id, code, created_by_user_id, expires_at, created_at
+`
+
+// Sets the join code of a game, replacing any code it already has. Returns `ErrJoinCodeTaken`
+// if another game is using the code.
+func ReplaceGameJoinCode(
+	ctx context.Context,
+	tx *sql.Tx,
+	gameID, code, createdByUserID string,
+	expiresAt time.Time,
+) (*dbModels.GameJoinCode, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		joinCodeComponent,
+		"ReplaceGameJoinCode",
+	).With().Str(l.GameIDKey, gameID).Str(l.JoinCodeKey, code).Logger()
+
+	query := `
+  INSERT INTO game_join_codes (game_id, code, created_by_user_id, expires_at)
+  VALUES ($1, $2, $3, $4)
+  ON CONFLICT (game_id) DO UPDATE SET
+    code = EXCLUDED.code,
+    created_by_user_id = EXCLUDED.created_by_user_id,
+    expires_at = EXCLUDED.expires_at,
+    created_at = CURRENT_TIMESTAMP
+  RETURNING` + gameJoinCodeColumns + `;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to replace game join code")
+
+	joinCode, err := scanGameJoinCode(querier.QueryRowContext(ctx, query, gameID, code, NullString(createdByUserID), expiresAt))
+	if err != nil {
+		constraintMappings := map[string]error{
+			"uq_game_join_codes_code": ErrJoinCodeTaken,
+		}
+		handled, appErr := HandlePgError(err, logger, constraintMappings)
+		if handled {
+			return nil, appErr
+		}
+		logger.Error().Err(err).Msg("Failed to replace game join code")
+		return nil, fmt.Errorf("error replacing join code for game %s: %w", gameID, err)
+	}
+
+	logger.Info().Msg("Game join code replaced successfully")
+	return joinCode, nil
+}
+
+// Retrieves the join code of a game, returns `ErrJoinCodeNotFound` if the game has none
+func GetGameJoinCodeByGameID(ctx context.Context, tx *sql.Tx, gameID string) (*dbModels.GameJoinCode, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		joinCodeComponent,
+		"GetGameJoinCodeByGameID",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT` + gameJoinCodeColumns + `
+  FROM game_join_codes
+  WHERE game_id = $1;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get game join code")
+
+	joinCode, err := scanGameJoinCode(querier.QueryRowContext(ctx, query, gameID))
+	if err != nil {
+		if errors.Is(err, ErrJoinCodeNotFound) {
+			logger.Debug().Msg("Game has no join code")
+		} else {
+			logger.Error().Err(err).Msg("Failed to get game join code")
+		}
+		return nil, err
+	}
+
+	logger.Info().Msg("Game join code retrieved successfully")
+	return joinCode, nil
+}
+
+// Retrieves a join code by the code itself, returns `ErrJoinCodeNotFound` if no game uses it
+func GetGameJoinCodeByCode(ctx context.Context, tx *sql.Tx, code string) (*dbModels.GameJoinCode, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		joinCodeComponent,
+		"GetGameJoinCodeByCode",
+	).With().Str(l.JoinCodeKey, code).Logger()
+
+	query := `
+  SELECT` + gameJoinCodeColumns + `
+  FROM game_join_codes
+  WHERE code = $1;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get join code")
+
+	joinCode, err := scanGameJoinCode(querier.QueryRowContext(ctx, query, code))
+	if err != nil {
+		if errors.Is(err, ErrJoinCodeNotFound) {
+			logger.Debug().Msg("Join code does not exist")
+		} else {
+			logger.Error().Err(err).Msg("Failed to get join code")
+		}
+		return nil, err
+	}
+
+	logger.Info().Str(l.GameIDKey, joinCode.GameID).Msg("Join code retrieved successfully")
+	return joinCode, nil
+}
+
+// Removes the join code of a game so it can no longer be used, returns `ErrJoinCodeNotFound` if
+// the game has none
+func DeleteGameJoinCode(ctx context.Context, tx *sql.Tx, gameID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		joinCodeComponent,
+		"DeleteGameJoinCode",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := "DELETE FROM game_join_codes WHERE game_id = $1;"
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to delete game join code")
+
+	result, err := querier.ExecContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to delete game join code")
+		return fmt.Errorf("error deleting join code for game %s: %w", gameID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after deleting game join code")
+		return fmt.Errorf("error checking rows affected for game %s join code deletion: %w", gameID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("No join code found to delete")
+		return ErrJoinCodeNotFound
+	}
+
+	logger.Info().Msg("Game join code deleted successfully")
+	return nil
+}
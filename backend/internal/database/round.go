@@ -0,0 +1,778 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	l "github.com/seankim658/skullking/internal/logger"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+	"github.com/seankim658/skullking/internal/scoring"
+)
+
+const roundComponent = "database-round"
+
+const roundColumns = `
+    round_id, game_id, round_number, cards_dealt, dealer_game_player_id, status,
+    is_tiebreaker_round, kraken_discarded_tricks, bids_revealed_at, version, created_at, updated_at
+`
+
+const playerRoundScoreColumns = `
+    player_round_score_id, round_id, game_player_id, bid_amount, tricks_taken,
+    round_score, bonus_points_applied, created_at, updated_at
+`
+
+// Player round score columns qualified with the `prs` alias, for queries joining other tables
+const prefixedPlayerRoundScoreColumns = `
+    prs.player_round_score_id, prs.round_id, prs.game_player_id, prs.bid_amount, prs.tricks_taken,
+    prs.round_score, prs.bonus_points_applied, prs.created_at, prs.updated_at
+`
+
+// Inserts a new round for a game in the bidding status
+func CreateRound(
+	ctx context.Context,
+	tx *sql.Tx,
+	gameID string,
+	roundNumber, cardsDealt int,
+	dealerGamePlayerID string,
+	isTiebreakerRound bool,
+) (string, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"CreateRound",
+	).With().
+		Str(l.GameIDKey, gameID).
+		Int(l.RoundNumberKey, roundNumber).
+		Int(l.CardsDealtKey, cardsDealt).
+		Bool("is_tiebreaker_round", isTiebreakerRound).
+		Logger()
+
+	newRoundID := uuid.NewString()
+	currentTime := time.Now()
+
+	query := `
+  INSERT INTO rounds (
+    round_id, game_id, round_number, cards_dealt, dealer_game_player_id, status,
+    is_tiebreaker_round, created_at, updated_at
+  )
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+  RETURNING round_id;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to create round")
+
+	var returnedRoundID string
+	err := querier.QueryRowContext(ctx, query,
+		newRoundID,
+		gameID,
+		roundNumber,
+		cardsDealt,
+		dealerGamePlayerID,
+		dbModels.RoundStatusBidding,
+		isTiebreakerRound,
+		currentTime,
+		currentTime,
+	).Scan(&returnedRoundID)
+	if err != nil {
+		constraintMappings := map[string]error{
+			"uq_game_round": ErrRoundAlreadyExists,
+		}
+		handled, appErr := HandlePgError(err, logger, constraintMappings)
+		if handled {
+			return "", appErr
+		}
+		logger.Error().Err(err).Msg("Failed to create round")
+		return "", fmt.Errorf("error creating round %d for game %s: %w", roundNumber, gameID, err)
+	}
+
+	logger.Info().Str(l.RoundIDKey, returnedRoundID).Msg("Round created successfully")
+	return returnedRoundID, nil
+}
+
+// Retrieves a round by its ID
+func GetRoundByID(ctx context.Context, tx *sql.Tx, roundID string) (*dbModels.Round, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"GetRoundByID",
+	).With().Str(l.RoundIDKey, roundID).Logger()
+
+	query := `
+  SELECT` + roundColumns + `
+  FROM rounds
+  WHERE round_id = $1;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get round by ID")
+
+	round, err := scanRound(querier.QueryRowContext(ctx, query, roundID))
+	if err != nil {
+		if errors.Is(err, ErrRoundNotFound) {
+			logger.Warn().Msg("Round not found by ID")
+		} else {
+			logger.Error().Err(err).Msg("Failed to get round by ID")
+		}
+		return nil, err
+	}
+	logger.Info().Msg("Round retrieved successfully by ID")
+	return round, nil
+}
+
+// Retrieves every round of a game in round order
+func GetRoundsByGameID(ctx context.Context, tx *sql.Tx, gameID string) ([]dbModels.Round, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"GetRoundsByGameID",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT` + roundColumns + `
+  FROM rounds
+  WHERE game_id = $1
+  ORDER BY round_number;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get rounds for game")
+
+	rows, err := querier.QueryContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query rounds for game")
+		return nil, fmt.Errorf("error querying rounds for game %s: %w", gameID, err)
+	}
+	defer rows.Close()
+
+	var rounds []dbModels.Round
+	for rows.Next() {
+		round, err := scanRound(rows)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to scan round row")
+			return nil, err
+		}
+		rounds = append(rounds, *round)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over round rows")
+		return nil, fmt.Errorf("error iterating round rows for game %s: %w", gameID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(rounds)).Msg("Rounds for game retrieved successfully")
+	return rounds, nil
+}
+
+// Retrieves the round with the highest round number for a game, returns `ErrRoundNotFound`
+// if the game has no rounds yet
+func GetLatestRoundByGameID(ctx context.Context, tx *sql.Tx, gameID string) (*dbModels.Round, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"GetLatestRoundByGameID",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT` + roundColumns + `
+  FROM rounds
+  WHERE game_id = $1
+  ORDER BY round_number DESC
+  LIMIT 1;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get latest round for game")
+
+	round, err := scanRound(querier.QueryRowContext(ctx, query, gameID))
+	if err != nil {
+		if errors.Is(err, ErrRoundNotFound) {
+			logger.Debug().Msg("Game has no rounds yet")
+		} else {
+			logger.Error().Err(err).Msg("Failed to get latest round for game")
+		}
+		return nil, err
+	}
+	logger.Info().Int(l.RoundNumberKey, round.RoundNumber).Msg("Latest round retrieved successfully")
+	return round, nil
+}
+
+// Retrieves a game's round by its round number
+func GetRoundByNumber(ctx context.Context, tx *sql.Tx, gameID string, roundNumber int) (*dbModels.Round, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"GetRoundByNumber",
+	).With().Str(l.GameIDKey, gameID).Int(l.RoundNumberKey, roundNumber).Logger()
+
+	query := `
+  SELECT` + roundColumns + `
+  FROM rounds
+  WHERE game_id = $1 AND round_number = $2;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get round by number")
+
+	round, err := scanRound(querier.QueryRowContext(ctx, query, gameID, roundNumber))
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to get round by number")
+		return nil, err
+	}
+	logger.Info().Str(l.RoundIDKey, round.RoundID).Msg("Round retrieved successfully")
+	return round, nil
+}
+
+// Moves a round from one status to another. Returns `ErrRoundStatusConflict` if the round
+// is no longer in the expected status, which guards against concurrent transitions.
+func UpdateRoundStatus(ctx context.Context, tx *sql.Tx, roundID, fromStatus, toStatus string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"UpdateRoundStatus",
+	).With().Str(l.RoundIDKey, roundID).Str("from_status", fromStatus).Str(l.StatusKey, toStatus).Logger()
+
+	query := `
+  UPDATE rounds
+  SET status = $1, updated_at = NOW()
+  WHERE round_id = $2 AND status = $3;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to update round status")
+
+	result, err := querier.ExecContext(ctx, query, toStatus, roundID, fromStatus)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to update round status")
+		return fmt.Errorf("error updating status for round %s: %w", roundID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after updating round status")
+		return fmt.Errorf("error checking rows affected for round %s status update: %w", roundID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("Round not found or not in the expected status")
+		return ErrRoundStatusConflict
+	}
+
+	logger.Info().Msg("Round status updated successfully")
+	return nil
+}
+
+// Inserts or replaces a player's bid for a round
+func UpsertPlayerRoundBid(ctx context.Context, tx *sql.Tx, roundID, gamePlayerID string, bidAmount int) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"UpsertPlayerRoundBid",
+	).With().
+		Str(l.RoundIDKey, roundID).
+		Str(l.GamePlayerIDKey, gamePlayerID).
+		Int(l.BidAmountKey, bidAmount).
+		Logger()
+
+	currentTime := time.Now()
+
+	query := `
+  INSERT INTO player_round_scores (
+    player_round_score_id, round_id, game_player_id, bid_amount, created_at, updated_at
+  )
+  VALUES ($1, $2, $3, $4, $5, $6)
+  ON CONFLICT (round_id, game_player_id)
+  DO UPDATE SET bid_amount = EXCLUDED.bid_amount, updated_at = EXCLUDED.updated_at;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to upsert player bid")
+
+	_, err := querier.ExecContext(ctx, query,
+		uuid.NewString(),
+		roundID,
+		gamePlayerID,
+		bidAmount,
+		currentTime,
+		currentTime,
+	)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to upsert player bid")
+		return fmt.Errorf("error saving bid for player %s in round %s: %w", gamePlayerID, roundID, err)
+	}
+
+	logger.Info().Msg("Player bid saved successfully")
+	return nil
+}
+
+// Records how many tricks the Kraken destroyed in a round
+func SetRoundKrakenDiscardedTricks(ctx context.Context, tx *sql.Tx, roundID string, discardedTricks int) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"SetRoundKrakenDiscardedTricks",
+	).With().Str(l.RoundIDKey, roundID).Int("kraken_discarded_tricks", discardedTricks).Logger()
+
+	query := `
+  UPDATE rounds
+  SET kraken_discarded_tricks = $1, updated_at = NOW()
+  WHERE round_id = $2;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to set kraken discarded tricks")
+
+	result, err := querier.ExecContext(ctx, query, discardedTricks, roundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to set kraken discarded tricks")
+		return fmt.Errorf("error setting kraken discarded tricks for round %s: %w", roundID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after setting kraken discarded tricks")
+		return fmt.Errorf("error checking rows affected for round %s: %w", roundID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("No round found to set kraken discarded tricks")
+		return ErrRoundNotFound
+	}
+
+	logger.Info().Msg("Kraken discarded tricks set successfully")
+	return nil
+}
+
+// Reveals the bids of a round to everyone, or hides them again when the round goes back to
+// bidding. Revealing keeps the time the bids were first revealed.
+func SetRoundBidsRevealed(ctx context.Context, tx *sql.Tx, roundID string, revealed bool) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"SetRoundBidsRevealed",
+	).With().Str(l.RoundIDKey, roundID).Bool("bids_revealed", revealed).Logger()
+
+	query := `
+  UPDATE rounds
+  SET bids_revealed_at = CASE WHEN $1 THEN COALESCE(bids_revealed_at, NOW()) END, updated_at = NOW()
+  WHERE round_id = $2;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to set whether round bids are revealed")
+
+	result, err := querier.ExecContext(ctx, query, revealed, roundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to set whether round bids are revealed")
+		return fmt.Errorf("error setting bids revealed for round %s: %w", roundID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after setting bids revealed")
+		return fmt.Errorf("error checking rows affected for round %s: %w", roundID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("No round found to set bids revealed")
+		return ErrRoundNotFound
+	}
+
+	logger.Info().Msg("Round bids revealed set successfully")
+	return nil
+}
+
+// Corrects the bid of a player who has already bid in a round. The round score is left
+// unchanged, completed rounds must be rescored afterwards.
+func UpdatePlayerRoundBid(ctx context.Context, tx *sql.Tx, roundID, gamePlayerID string, bidAmount int) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"UpdatePlayerRoundBid",
+	).With().
+		Str(l.RoundIDKey, roundID).
+		Str(l.GamePlayerIDKey, gamePlayerID).
+		Int(l.BidAmountKey, bidAmount).
+		Logger()
+
+	query := `
+  UPDATE player_round_scores
+  SET bid_amount = $1, updated_at = NOW()
+  WHERE round_id = $2 AND game_player_id = $3;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to update player bid")
+
+	result, err := querier.ExecContext(ctx, query, bidAmount, roundID, gamePlayerID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to update player bid")
+		return fmt.Errorf("error updating bid for player %s in round %s: %w", gamePlayerID, roundID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after updating player bid")
+		return fmt.Errorf("error checking rows affected for player %s bid in round %s: %w", gamePlayerID, roundID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("No bid found for player in round")
+		return ErrPlayerRoundScoreNotFound
+	}
+
+	logger.Info().Msg("Player bid updated successfully")
+	return nil
+}
+
+// Retrieves all player scores recorded for a round
+func GetPlayerRoundScoresByRoundID(ctx context.Context, tx *sql.Tx, roundID string) ([]dbModels.PlayerRoundScore, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"GetPlayerRoundScoresByRoundID",
+	).With().Str(l.RoundIDKey, roundID).Logger()
+
+	query := `
+  SELECT` + playerRoundScoreColumns + `
+  FROM player_round_scores
+  WHERE round_id = $1
+  ORDER BY (
+    SELECT gp.seating_order FROM game_players gp
+    WHERE gp.game_player_id = player_round_scores.game_player_id
+  );
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get player scores for round")
+
+	rows, err := querier.QueryContext(ctx, query, roundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query player scores for round")
+		return nil, fmt.Errorf("error querying player scores for round %s: %w", roundID, err)
+	}
+	defer rows.Close()
+
+	var scores []dbModels.PlayerRoundScore
+	for rows.Next() {
+		score, err := scanPlayerRoundScore(rows)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to scan player round score row")
+			return nil, err
+		}
+		scores = append(scores, *score)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over player round score rows")
+		return nil, fmt.Errorf("error iterating player score rows for round %s: %w", roundID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(scores)).Msg("Player scores for round retrieved successfully")
+	return scores, nil
+}
+
+// Retrieves the player scores of every round of a game, ordered by round and seating order
+func GetPlayerRoundScoresByGameID(ctx context.Context, tx *sql.Tx, gameID string) ([]dbModels.PlayerRoundScore, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"GetPlayerRoundScoresByGameID",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT` + prefixedPlayerRoundScoreColumns + `
+  FROM player_round_scores prs
+  JOIN rounds r ON r.round_id = prs.round_id
+  JOIN game_players gp ON gp.game_player_id = prs.game_player_id
+  WHERE r.game_id = $1
+  ORDER BY r.round_number, gp.seating_order;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get player scores for game")
+
+	rows, err := querier.QueryContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query player scores for game")
+		return nil, fmt.Errorf("error querying player scores for game %s: %w", gameID, err)
+	}
+	defer rows.Close()
+
+	var scores []dbModels.PlayerRoundScore
+	for rows.Next() {
+		score, err := scanPlayerRoundScore(rows)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to scan player round score row")
+			return nil, err
+		}
+		scores = append(scores, *score)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over player round score rows")
+		return nil, fmt.Errorf("error iterating player score rows for game %s: %w", gameID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(scores)).Msg("Player scores for game retrieved successfully")
+	return scores, nil
+}
+
+// Records the tricks taken and bonus points for a player's round. The round score is
+// always computed by the scoring engine from the stored bid under the game's ruleset, never
+// accepted from callers.
+func RecordPlayerRoundResult(
+	ctx context.Context,
+	tx *sql.Tx,
+	roundID, gamePlayerID string,
+	ruleset scoring.Ruleset,
+	cardsDealt, tricksTaken, bonusPoints int,
+) (*dbModels.PlayerRoundScore, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"RecordPlayerRoundResult",
+	).With().
+		Str(l.RoundIDKey, roundID).
+		Str(l.GamePlayerIDKey, gamePlayerID).
+		Int(l.TricksTakenKey, tricksTaken).
+		Logger()
+
+	queryBid := `
+  SELECT bid_amount
+  FROM player_round_scores
+  WHERE round_id = $1 AND game_player_id = $2
+  FOR UPDATE;
+  `
+	logger.Debug().Str(l.QueryKey, queryBid).Msg("Attempting to get bid for player round")
+
+	var bid int
+	err := querier.QueryRowContext(ctx, queryBid, roundID, gamePlayerID).Scan(&bid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn().Msg("No bid found for player in round")
+			return nil, ErrPlayerRoundScoreNotFound
+		}
+		logger.Error().Err(err).Msg("Failed to get bid for player round")
+		return nil, fmt.Errorf("error getting bid for player %s in round %s: %w", gamePlayerID, roundID, err)
+	}
+
+	score, err := ruleset.CalculateRoundScore(cardsDealt, bid, tricksTaken, bonusPoints)
+	if err != nil {
+		logger.Warn().Err(err).Int(l.BidAmountKey, bid).Msg("Scoring engine rejected round result")
+		return nil, err
+	}
+	logger = logger.With().Int(l.BidAmountKey, bid).Int(l.RoundScoreKey, score.RoundScore).Logger()
+
+	queryUpdate := `
+  UPDATE player_round_scores
+  SET tricks_taken = $1, round_score = $2, bonus_points_applied = $3, updated_at = NOW()
+  WHERE round_id = $4 AND game_player_id = $5
+  RETURNING` + playerRoundScoreColumns + ";"
+	logger.Debug().Str(l.QueryKey, queryUpdate).Msg("Attempting to record player round result")
+
+	playerScore, err := scanPlayerRoundScore(querier.QueryRowContext(ctx, queryUpdate,
+		tricksTaken,
+		score.RoundScore,
+		score.BonusPointsApplied,
+		roundID,
+		gamePlayerID,
+	))
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to record player round result")
+		return nil, err
+	}
+
+	logger.Info().Msg("Player round result recorded successfully")
+	return playerScore, nil
+}
+
+// Retrieves the scores from every completed tiebreaker round of a game, in round order.
+// Each entry maps the participating game player IDs to their score for that round.
+func GetTiebreakerRoundScores(ctx context.Context, tx *sql.Tx, gameID string) ([]map[string]int, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"GetTiebreakerRoundScores",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT r.round_number, prs.game_player_id, prs.round_score
+  FROM rounds r
+  JOIN player_round_scores prs ON prs.round_id = r.round_id
+  WHERE r.game_id = $1 AND r.is_tiebreaker_round AND r.status = 'completed'
+  ORDER BY r.round_number;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get tiebreaker round scores")
+
+	rows, err := querier.QueryContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query tiebreaker round scores")
+		return nil, fmt.Errorf("error querying tiebreaker scores for game %s: %w", gameID, err)
+	}
+	defer rows.Close()
+
+	var roundScores []map[string]int
+	lastRoundNumber := 0
+	for rows.Next() {
+		var roundNumber, score int
+		var gamePlayerID string
+		if err := rows.Scan(&roundNumber, &gamePlayerID, &score); err != nil {
+			logger.Error().Err(err).Msg("Failed to scan tiebreaker score row")
+			return nil, fmt.Errorf("error scanning tiebreaker score row for game %s: %w", gameID, err)
+		}
+		if roundNumber != lastRoundNumber {
+			roundScores = append(roundScores, make(map[string]int))
+			lastRoundNumber = roundNumber
+		}
+		roundScores[len(roundScores)-1][gamePlayerID] = score
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over tiebreaker score rows")
+		return nil, fmt.Errorf("error iterating tiebreaker score rows for game %s: %w", gameID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(roundScores)).Msg("Tiebreaker round scores retrieved successfully")
+	return roundScores, nil
+}
+
+// Clears the recorded tricks taken and scores of every player in a round, leaving their bids
+func ClearPlayerRoundResults(ctx context.Context, tx *sql.Tx, roundID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"ClearPlayerRoundResults",
+	).With().Str(l.RoundIDKey, roundID).Logger()
+
+	query := `
+  UPDATE player_round_scores
+  SET tricks_taken = NULL, bonus_points_applied = 0, round_score = 0, updated_at = NOW()
+  WHERE round_id = $1;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to clear player round results")
+
+	if _, err := querier.ExecContext(ctx, query, roundID); err != nil {
+		logger.Error().Err(err).Msg("Failed to clear player round results")
+		return fmt.Errorf("error clearing player results for round %s: %w", roundID, err)
+	}
+
+	logger.Info().Msg("Player round results cleared successfully")
+	return nil
+}
+
+// Deletes a single player's score from a round, e.g. to undo a bid they submitted themselves.
+// Returns `ErrPlayerRoundScoreNotFound` if the player has no score in the round.
+func DeletePlayerRoundScore(ctx context.Context, tx *sql.Tx, roundID, gamePlayerID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"DeletePlayerRoundScore",
+	).With().Str(l.RoundIDKey, roundID).Str(l.GamePlayerIDKey, gamePlayerID).Logger()
+
+	query := "DELETE FROM player_round_scores WHERE round_id = $1 AND game_player_id = $2;"
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to delete player round score")
+
+	result, err := querier.ExecContext(ctx, query, roundID, gamePlayerID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to delete player round score")
+		return fmt.Errorf("error deleting score for player %s in round %s: %w", gamePlayerID, roundID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after deleting player round score")
+		return fmt.Errorf("error checking rows affected for player %s score in round %s: %w", gamePlayerID, roundID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("No score found for player in round")
+		return ErrPlayerRoundScoreNotFound
+	}
+
+	logger.Info().Msg("Player round score deleted successfully")
+	return nil
+}
+
+// Deletes every player score recorded for a round
+func DeletePlayerRoundScoresByRoundID(ctx context.Context, tx *sql.Tx, roundID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"DeletePlayerRoundScoresByRoundID",
+	).With().Str(l.RoundIDKey, roundID).Logger()
+
+	query := "DELETE FROM player_round_scores WHERE round_id = $1;"
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to delete player round scores")
+
+	result, err := querier.ExecContext(ctx, query, roundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to delete player round scores")
+		return fmt.Errorf("error deleting player scores for round %s: %w", roundID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after deleting player round scores")
+		return fmt.Errorf("error checking rows affected for round %s score deletion: %w", roundID, err)
+	}
+
+	logger.Info().Int64(l.CountKey, rowsAffected).Msg("Player round scores deleted successfully")
+	return nil
+}
+
+// Deletes a round along with its player scores and bonus events
+func DeleteRound(ctx context.Context, tx *sql.Tx, roundID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"DeleteRound",
+	).With().Str(l.RoundIDKey, roundID).Logger()
+
+	query := "DELETE FROM rounds WHERE round_id = $1;"
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to delete round")
+
+	result, err := querier.ExecContext(ctx, query, roundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to delete round")
+		return fmt.Errorf("error deleting round %s: %w", roundID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after deleting round")
+		return fmt.Errorf("error checking rows affected for round %s deletion: %w", roundID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("No round found to delete")
+		return ErrRoundNotFound
+	}
+
+	logger.Info().Msg("Round deleted successfully")
+	return nil
+}
+
+// Locks a round row for the rest of the transaction and returns its current version, so the
+// version can be compared before writing without another request changing it in between.
+// Returns `ErrRoundNotFound` if the round does not exist.
+func LockRoundVersion(ctx context.Context, tx *sql.Tx, roundID string) (int, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundComponent,
+		"LockRoundVersion",
+	).With().Str(l.RoundIDKey, roundID).Logger()
+
+	query := `
+  SELECT version
+  FROM rounds
+  WHERE round_id = $1
+  FOR UPDATE;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to lock round version")
+
+	var version int
+	if err := querier.QueryRowContext(ctx, query, roundID).Scan(&version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn().Msg("Round not found to lock")
+			return 0, ErrRoundNotFound
+		}
+		logger.Error().Err(err).Msg("Failed to lock round version")
+		return 0, fmt.Errorf("error locking version of round %s: %w", roundID, err)
+	}
+	return version, nil
+}
@@ -50,6 +50,8 @@ func scanGameSession(row RowScanner) (*dbModels.GameSession, error) {
 		&s.CreatedAt,
 		&s.UpdatedAt,
 		&s.CompletedAt,
+		&s.AbandonedAt,
+		&s.AbandonReason,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -71,9 +73,20 @@ func scanGame(row RowScanner) (*dbModels.Game, error) {
 		&g.Status,
 		&g.StartingDealerGamePlayerID,
 		&g.PlayerSeatingOrderRandomized,
+		&g.TiebreakerRule,
+		&g.Ruleset,
+		&g.KrakenEnabled,
+		&g.WhiteWhaleEnabled,
+		&g.LootEnabled,
+		&g.RoundSchedule,
+		&g.Visibility,
+		&g.Version,
 		&g.CreatedAt,
 		&g.UpdatedAt,
+		&g.StartedAt,
 		&g.CompletedAt,
+		&g.AbandonedAt,
+		&g.AbandonReason,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -112,6 +125,9 @@ func scanGamePlayer(row RowScanner) (*dbModels.GamePlayer, error) {
 		&p.SeatingOrder,
 		&p.FinalScore,
 		&p.FinishingPosition,
+		&p.LeftAt,
+		&p.JoinedAt,
+		&p.JoinedWithCode,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -121,3 +137,197 @@ func scanGamePlayer(row RowScanner) (*dbModels.GamePlayer, error) {
 	}
 	return p, nil
 }
+
+// Scan a round row
+func scanRound(row RowScanner) (*dbModels.Round, error) {
+	rd := &dbModels.Round{}
+	err := row.Scan(
+		&rd.RoundID,
+		&rd.GameID,
+		&rd.RoundNumber,
+		&rd.CardsDealt,
+		&rd.DealerGamePlayerID,
+		&rd.Status,
+		&rd.IsTiebreakerRound,
+		&rd.KrakenDiscardedTricks,
+		&rd.BidsRevealedAt,
+		&rd.Version,
+		&rd.CreatedAt,
+		&rd.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRoundNotFound
+		}
+		return nil, fmt.Errorf("error scanning round data: %w", err)
+	}
+	return rd, nil
+}
+
+// Scan a player round score row
+func scanPlayerRoundScore(row RowScanner) (*dbModels.PlayerRoundScore, error) {
+	s := &dbModels.PlayerRoundScore{}
+	err := row.Scan(
+		&s.PlayerRoundScoreID,
+		&s.RoundID,
+		&s.GamePlayerID,
+		&s.BidAmount,
+		&s.TricksTaken,
+		&s.RoundScore,
+		&s.BonusPointsApplied,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPlayerRoundScoreNotFound
+		}
+		return nil, fmt.Errorf("error scanning player round score data: %w", err)
+	}
+	return s, nil
+}
+
+func scanRoundBonusEvent(row RowScanner) (*dbModels.RoundBonusEvent, error) {
+	e := &dbModels.RoundBonusEvent{}
+	err := row.Scan(
+		&e.BonusEventID,
+		&e.RoundID,
+		&e.GamePlayerID,
+		&e.BonusType,
+		&e.AllyGamePlayerID,
+		&e.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRoundBonusEventNotFound
+		}
+		return nil, fmt.Errorf("error scanning round bonus event data: %w", err)
+	}
+	return e, nil
+}
+
+func scanScoreAuditEntry(row RowScanner) (*dbModels.ScoreAuditEntry, error) {
+	a := &dbModels.ScoreAuditEntry{}
+	err := row.Scan(
+		&a.AuditID,
+		&a.GameID,
+		&a.RoundID,
+		&a.GamePlayerID,
+		&a.TableName,
+		&a.Operation,
+		&a.FieldName,
+		&a.OldValue,
+		&a.NewValue,
+		&a.ChangedByUserID,
+		&a.ChangedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrScoreAuditEntryNotFound
+		}
+		return nil, fmt.Errorf("error scanning score audit entry data: %w", err)
+	}
+	return a, nil
+}
+
+// Scan a player game asterisk row
+func scanPlayerGameAsterisk(row RowScanner) (*dbModels.PlayerGameAsterisk, error) {
+	a := &dbModels.PlayerGameAsterisk{}
+	err := row.Scan(
+		&a.PlayerGameAsteriskID,
+		&a.GamePlayerID,
+		&a.GameID,
+		&a.Reason,
+		&a.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPlayerGameAsteriskNotFound
+		}
+		return nil, fmt.Errorf("error scanning player game asterisk data: %w", err)
+	}
+	return a, nil
+}
+
+
+// Scan a scorekeeper handoff row
+func scanScorekeeperHandoff(row RowScanner) (*dbModels.ScorekeeperHandoff, error) {
+	h := &dbModels.ScorekeeperHandoff{}
+	err := row.Scan(
+		&h.HandoffID,
+		&h.GameID,
+		&h.FromUserID,
+		&h.ToUserID,
+		&h.HandoffType,
+		&h.Status,
+		&h.CreatedAt,
+		&h.ResolvedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrScorekeeperHandoffNotFound
+		}
+		return nil, fmt.Errorf("error scanning scorekeeper handoff data: %w", err)
+	}
+	return h, nil
+}
+
+// Scan a sync action row
+func scanSyncAction(row RowScanner) (*dbModels.SyncAction, error) {
+	a := &dbModels.SyncAction{}
+	err := row.Scan(
+		&a.GameID,
+		&a.ClientActionID,
+		&a.ActionType,
+		&a.Status,
+		&a.Reason,
+		&a.AppliedByUserID,
+		&a.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSyncActionNotFound
+		}
+		return nil, fmt.Errorf("error scanning sync action data: %w", err)
+	}
+	return a, nil
+}
+
+// Scan a game join code row
+func scanGameJoinCode(row RowScanner) (*dbModels.GameJoinCode, error) {
+	c := &dbModels.GameJoinCode{}
+	err := row.Scan(
+		&c.GameID,
+		&c.Code,
+		&c.CreatedByUserID,
+		&c.ExpiresAt,
+		&c.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrJoinCodeNotFound
+		}
+		return nil, fmt.Errorf("error scanning game join code data: %w", err)
+	}
+	return c, nil
+}
+
+// Scan a share link row
+func scanShareLink(row RowScanner) (*dbModels.ShareLink, error) {
+	s := &dbModels.ShareLink{}
+	err := row.Scan(
+		&s.ShareLinkID,
+		&s.GameID,
+		&s.SessionID,
+		&s.CreatedByUserID,
+		&s.CreatedAt,
+		&s.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrShareLinkNotFound
+		}
+		return nil, fmt.Errorf("error scanning share link data: %w", err)
+	}
+	return s, nil
+}
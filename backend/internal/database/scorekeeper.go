@@ -0,0 +1,292 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	l "github.com/seankim658/skullking/internal/logger"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const scorekeeperComponent = "database-scorekeeper"
+
+const scorekeeperHandoffColumns = `
+    handoff_id, game_id, from_user_id, to_user_id, handoff_type, status, created_at, resolved_at
+`
+
+// Moves scorekeeping of a game from one user to another. Returns `ErrScorekeeperChanged` if
+// fromUserID is no longer the game's scorekeeper, which guards against concurrent handoffs.
+func SetGameScorekeeper(ctx context.Context, tx *sql.Tx, gameID, fromUserID, toUserID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperComponent,
+		"SetGameScorekeeper",
+	).With().Str(l.GameIDKey, gameID).Str(l.ScorekeeperIDKey, toUserID).Logger()
+
+	query := `
+  UPDATE games
+  SET current_scorekeeper_user_id = $1, updated_at = NOW()
+  WHERE game_id = $2 AND current_scorekeeper_user_id = $3;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to set game scorekeeper")
+
+	result, err := querier.ExecContext(ctx, query, toUserID, gameID, fromUserID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to set game scorekeeper")
+		return fmt.Errorf("error setting scorekeeper for game %s: %w", gameID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after setting game scorekeeper")
+		return fmt.Errorf("error checking rows affected for game %s scorekeeper update: %w", gameID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("Game not found or scorekeeper has changed")
+		return ErrScorekeeperChanged
+	}
+
+	logger.Info().Msg("Game scorekeeper set successfully")
+	return nil
+}
+
+// Records a scorekeeper handoff. Completed handoffs are resolved immediately, pending ones
+// wait for the scorekeeper. Returns `ErrScorekeeperRequestPending` if the user already has a
+// pending request for the game.
+func CreateScorekeeperHandoff(
+	ctx context.Context,
+	tx *sql.Tx,
+	gameID, fromUserID, toUserID, handoffType, status string,
+) (*dbModels.ScorekeeperHandoff, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperComponent,
+		"CreateScorekeeperHandoff",
+	).With().
+		Str(l.GameIDKey, gameID).
+		Str("from_user_id", fromUserID).
+		Str("to_user_id", toUserID).
+		Str("handoff_type", handoffType).
+		Str(l.StatusKey, status).
+		Logger()
+
+	currentTime := time.Now()
+	var resolvedAt sql.NullTime
+	if status != dbModels.HandoffStatusPending {
+		resolvedAt = sql.NullTime{Time: currentTime, Valid: true}
+	}
+
+	query := `
+  INSERT INTO scorekeeper_handoffs (
+    handoff_id, game_id, from_user_id, to_user_id, handoff_type, status, created_at, resolved_at
+  )
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+  RETURNING` + scorekeeperHandoffColumns + ";"
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to create scorekeeper handoff")
+
+	handoff, err := scanScorekeeperHandoff(querier.QueryRowContext(ctx, query,
+		uuid.NewString(),
+		gameID,
+		NullString(fromUserID),
+		toUserID,
+		handoffType,
+		status,
+		currentTime,
+		resolvedAt,
+	))
+	if err != nil {
+		constraintMappings := map[string]error{
+			"uq_scorekeeper_handoffs_pending_request": ErrScorekeeperRequestPending,
+		}
+		handled, appErr := HandlePgError(err, logger, constraintMappings)
+		if handled {
+			return nil, appErr
+		}
+		logger.Error().Err(err).Msg("Failed to create scorekeeper handoff")
+		return nil, err
+	}
+
+	logger.Info().Str(l.HandoffIDKey, handoff.HandoffID).Msg("Scorekeeper handoff created successfully")
+	return handoff, nil
+}
+
+// Retrieves a scorekeeper handoff of a game
+func GetScorekeeperHandoff(ctx context.Context, tx *sql.Tx, gameID, handoffID string) (*dbModels.ScorekeeperHandoff, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperComponent,
+		"GetScorekeeperHandoff",
+	).With().Str(l.GameIDKey, gameID).Str(l.HandoffIDKey, handoffID).Logger()
+
+	query := `
+  SELECT` + scorekeeperHandoffColumns + `
+  FROM scorekeeper_handoffs
+  WHERE handoff_id = $1 AND game_id = $2;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get scorekeeper handoff")
+
+	handoff, err := scanScorekeeperHandoff(querier.QueryRowContext(ctx, query, handoffID, gameID))
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to get scorekeeper handoff")
+		return nil, err
+	}
+
+	logger.Info().Msg("Scorekeeper handoff retrieved successfully")
+	return handoff, nil
+}
+
+// Retrieves every scorekeeper handoff of a game, oldest first
+func GetScorekeeperHandoffsByGameID(ctx context.Context, tx *sql.Tx, gameID string) ([]dbModels.ScorekeeperHandoff, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperComponent,
+		"GetScorekeeperHandoffsByGameID",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  SELECT` + scorekeeperHandoffColumns + `
+  FROM scorekeeper_handoffs
+  WHERE game_id = $1
+  ORDER BY created_at, handoff_id;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get scorekeeper handoffs for game")
+
+	rows, err := querier.QueryContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query scorekeeper handoffs")
+		return nil, fmt.Errorf("error querying scorekeeper handoffs for game %s: %w", gameID, err)
+	}
+	defer rows.Close()
+
+	var handoffs []dbModels.ScorekeeperHandoff
+	for rows.Next() {
+		handoff, err := scanScorekeeperHandoff(rows)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to scan scorekeeper handoff row")
+			return nil, err
+		}
+		handoffs = append(handoffs, *handoff)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over scorekeeper handoff rows")
+		return nil, fmt.Errorf("error iterating scorekeeper handoff rows for game %s: %w", gameID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(handoffs)).Msg("Scorekeeper handoffs retrieved successfully")
+	return handoffs, nil
+}
+
+// Resolves a pending scorekeeper handoff with the given status. Returns
+// `ErrScorekeeperHandoffResolved` if the handoff is no longer pending.
+func ResolveScorekeeperHandoff(ctx context.Context, tx *sql.Tx, handoffID, status string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperComponent,
+		"ResolveScorekeeperHandoff",
+	).With().Str(l.HandoffIDKey, handoffID).Str(l.StatusKey, status).Logger()
+
+	query := `
+  UPDATE scorekeeper_handoffs
+  SET status = $1, resolved_at = NOW()
+  WHERE handoff_id = $2 AND status = 'pending';
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to resolve scorekeeper handoff")
+
+	result, err := querier.ExecContext(ctx, query, status, handoffID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to resolve scorekeeper handoff")
+		return fmt.Errorf("error resolving scorekeeper handoff %s: %w", handoffID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after resolving scorekeeper handoff")
+		return fmt.Errorf("error checking rows affected for scorekeeper handoff %s: %w", handoffID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("Scorekeeper handoff not found or no longer pending")
+		return ErrScorekeeperHandoffResolved
+	}
+
+	logger.Info().Msg("Scorekeeper handoff resolved successfully")
+	return nil
+}
+
+// Cancels every pending scorekeeper request of a game, used once scorekeeping has changed hands
+func CancelPendingScorekeeperHandoffs(ctx context.Context, tx *sql.Tx, gameID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperComponent,
+		"CancelPendingScorekeeperHandoffs",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  UPDATE scorekeeper_handoffs
+  SET status = 'cancelled', resolved_at = NOW()
+  WHERE game_id = $1 AND status = 'pending';
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to cancel pending scorekeeper handoffs")
+
+	result, err := querier.ExecContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to cancel pending scorekeeper handoffs")
+		return fmt.Errorf("error cancelling pending scorekeeper handoffs for game %s: %w", gameID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after cancelling scorekeeper handoffs")
+		return fmt.Errorf("error checking rows affected for game %s handoff cancellation: %w", gameID, err)
+	}
+
+	logger.Info().Int64(l.CountKey, rowsAffected).Msg("Pending scorekeeper handoffs cancelled successfully")
+	return nil
+}
+
+// Cancels the pending scorekeeper requests of a game made by users who are no longer one of its
+// players, used after a player is removed or replaced
+func CancelScorekeeperRequestsOfDepartedPlayers(ctx context.Context, tx *sql.Tx, gameID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperComponent,
+		"CancelScorekeeperRequestsOfDepartedPlayers",
+	).With().Str(l.GameIDKey, gameID).Logger()
+
+	query := `
+  UPDATE scorekeeper_handoffs sh
+  SET status = 'cancelled', resolved_at = NOW()
+  WHERE sh.game_id = $1 AND sh.status = 'pending'
+    AND NOT EXISTS (
+      SELECT 1 FROM game_players gp
+      WHERE gp.game_id = sh.game_id AND gp.user_id = sh.to_user_id
+    );
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to cancel scorekeeper requests of departed players")
+
+	result, err := querier.ExecContext(ctx, query, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to cancel scorekeeper requests of departed players")
+		return fmt.Errorf("error cancelling scorekeeper requests of departed players for game %s: %w", gameID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after cancelling scorekeeper requests")
+		return fmt.Errorf("error checking rows affected for game %s request cancellation: %w", gameID, err)
+	}
+
+	logger.Info().Int64(l.CountKey, rowsAffected).Msg("Scorekeeper requests of departed players cancelled successfully")
+	return nil
+}
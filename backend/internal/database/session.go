@@ -14,6 +14,11 @@ import (
 
 const sessionComponent = "database-session"
 
+const gameSessionColumns = `
+    session_id, session_name, created_by_user_id, status, created_at, updated_at,
+    completed_at, abandoned_at, abandon_reason
+`
+
 // Inserts a new game session into the game sessions table
 func CreateGameSession(ctx context.Context, tx *sql.Tx, sessionName, createdByUserID string) (string, error) {
 	querier := GetQuerier(tx)
@@ -177,3 +182,101 @@ func UpdateSessionStatus(
 	logger.Info().Msg("Session status updated successfully")
 	return nil
 }
+
+// Bumps the updated_at timestamp of a game session to record activity within it
+func TouchSession(ctx context.Context, tx *sql.Tx, sessionID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		sessionComponent,
+		"TouchSession",
+	).With().Str(l.SessionIDKey, sessionID).Logger()
+
+	query := `
+  UPDATE game_sessions
+  SET updated_at = NOW()
+  WHERE session_id = $1;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to touch session")
+
+	result, err := querier.ExecContext(ctx, query, sessionID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to touch session")
+		return fmt.Errorf("error touching session %s: %w", sessionID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after touching session")
+		return fmt.Errorf("error checking rows affected for session %s touch: %w", sessionID, err)
+	}
+	if rowsAffected == 0 {
+		return ErrSessionNotFound
+	}
+
+	logger.Info().Msg("Session touched successfully")
+	return nil
+}
+
+// Retrieves a game session by ID
+func GetSessionByID(ctx context.Context, tx *sql.Tx, sessionID string) (*dbModels.GameSession, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		sessionComponent,
+		"GetSessionByID",
+	).With().Str(l.SessionIDKey, sessionID).Logger()
+
+	query := `
+  SELECT` + gameSessionColumns + `
+  FROM game_sessions
+  WHERE session_id = $1;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get session by ID")
+
+	session, err := scanGameSession(querier.QueryRowContext(ctx, query, sessionID))
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to get session")
+		return nil, err
+	}
+
+	logger.Info().Msg("Session retrieved successfully")
+	return session, nil
+}
+
+// Moves an active session to abandoned, recording when and optionally why. Returns
+// `ErrSessionStatusConflict` if the session is no longer active.
+func AbandonSession(ctx context.Context, tx *sql.Tx, sessionID string, reason *string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		sessionComponent,
+		"AbandonSession",
+	).With().Str(l.SessionIDKey, sessionID).Logger()
+
+	query := `
+  UPDATE game_sessions
+  SET status = 'abandoned', abandoned_at = NOW(), abandon_reason = $2, updated_at = NOW()
+  WHERE session_id = $1 AND status = 'active';
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to abandon session")
+
+	result, err := querier.ExecContext(ctx, query, sessionID, reason)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to abandon session")
+		return fmt.Errorf("error abandoning session %s: %w", sessionID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after abandoning session")
+		return fmt.Errorf("error checking rows affected for session %s abandonment: %w", sessionID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("Session not found or no longer active")
+		return ErrSessionStatusConflict
+	}
+
+	logger.Info().Msg("Session abandoned successfully")
+	return nil
+}
@@ -0,0 +1,164 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	l "github.com/seankim658/skullking/internal/logger"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const shareComponent = "database-share"
+
+const shareLinkColumns = `
+    share_link_id, game_id, session_id, created_by_user_id, created_at, revoked_at
+`
+
+// Creates a share link for a game or a session, exactly one of `gameID` and `sessionID` must be
+// given
+func CreateShareLink(ctx context.Context, tx *sql.Tx, gameID, sessionID, createdByUserID string) (*dbModels.ShareLink, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareComponent,
+		"CreateShareLink",
+	).With().
+		Str(l.GameIDKey, gameID).
+		Str(l.SessionIDKey, sessionID).
+		Str(l.UserIDKey, createdByUserID).
+		Logger()
+
+	query := `
+  INSERT INTO share_links (game_id, session_id, created_by_user_id)
+  VALUES ($1, $2, $3)
+  RETURNING` + shareLinkColumns + `;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to create share link")
+
+	link, err := scanShareLink(querier.QueryRowContext(ctx, query,
+		NullString(gameID),
+		NullString(sessionID),
+		NullString(createdByUserID),
+	))
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to create share link")
+		return nil, fmt.Errorf("error creating share link: %w", err)
+	}
+
+	logger.Info().Str(l.ShareLinkIDKey, link.ShareLinkID).Msg("Share link created successfully")
+	return link, nil
+}
+
+// Retrieves a share link by its ID, revoked or not. Returns `ErrShareLinkNotFound` if it
+// doesn't exist.
+func GetShareLinkByID(ctx context.Context, tx *sql.Tx, shareLinkID string) (*dbModels.ShareLink, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareComponent,
+		"GetShareLinkByID",
+	).With().Str(l.ShareLinkIDKey, shareLinkID).Logger()
+
+	query := `
+  SELECT` + shareLinkColumns + `
+  FROM share_links
+  WHERE share_link_id = $1;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get share link")
+
+	link, err := scanShareLink(querier.QueryRowContext(ctx, query, shareLinkID))
+	if err != nil {
+		if errors.Is(err, ErrShareLinkNotFound) {
+			logger.Warn().Msg("Share link not found")
+		} else {
+			logger.Error().Err(err).Msg("Failed to get share link")
+		}
+		return nil, err
+	}
+
+	logger.Info().Msg("Share link retrieved successfully")
+	return link, nil
+}
+
+// Retrieves the share links of a game or a session that haven't been revoked, newest first.
+// Exactly one of `gameID` and `sessionID` must be given.
+func GetActiveShareLinks(ctx context.Context, tx *sql.Tx, gameID, sessionID string) ([]dbModels.ShareLink, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareComponent,
+		"GetActiveShareLinks",
+	).With().Str(l.GameIDKey, gameID).Str(l.SessionIDKey, sessionID).Logger()
+
+	query := `
+  SELECT` + shareLinkColumns + `
+  FROM share_links
+  WHERE (game_id = $1 OR session_id = $2) AND revoked_at IS NULL
+  ORDER BY created_at DESC;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get active share links")
+
+	rows, err := querier.QueryContext(ctx, query, NullString(gameID), NullString(sessionID))
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query active share links")
+		return nil, fmt.Errorf("error querying active share links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []dbModels.ShareLink
+	for rows.Next() {
+		link, err := scanShareLink(rows)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to scan share link row")
+			return nil, err
+		}
+		links = append(links, *link)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over share link rows")
+		return nil, fmt.Errorf("error iterating share link rows: %w", err)
+	}
+
+	logger.Info().Int(l.CountKey, len(links)).Msg("Active share links retrieved successfully")
+	return links, nil
+}
+
+// Revokes a share link so its token no longer grants access. Returns `ErrShareLinkNotFound` if
+// the link doesn't exist or was already revoked.
+func RevokeShareLink(ctx context.Context, tx *sql.Tx, shareLinkID string) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareComponent,
+		"RevokeShareLink",
+	).With().Str(l.ShareLinkIDKey, shareLinkID).Logger()
+
+	query := `
+  UPDATE share_links
+  SET revoked_at = NOW()
+  WHERE share_link_id = $1 AND revoked_at IS NULL;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to revoke share link")
+
+	result, err := querier.ExecContext(ctx, query, shareLinkID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to revoke share link")
+		return fmt.Errorf("error revoking share link %s: %w", shareLinkID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after revoking share link")
+		return fmt.Errorf("error checking rows affected for share link %s: %w", shareLinkID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("No active share link found to revoke")
+		return ErrShareLinkNotFound
+	}
+
+	logger.Info().Msg("Share link revoked successfully")
+	return nil
+}
@@ -14,16 +14,32 @@ const statsComponent = "database-stats"
 type ProfileStats struct {
 	TotalGamesPlayed int
 	TotalWins        int
+	// Bonuses captured in completed games, keyed by bonus type
+	BonusCounts map[string]int
 }
 
+// Narrows the games that count towards a user's stats
+type StatsFilter struct {
+	// Only count games played with this ruleset, every ruleset is counted when empty
+	Ruleset string
+	// Leave out games with an asterisk on any player
+	ExcludeAsterisked bool
+}
+
+// Leaves out asterisked games when `StatsFilter.ExcludeAsterisked`, bound as $3, is true
+const excludeAsteriskedGamesCondition = `
+    AND (NOT $3 OR NOT EXISTS (
+      SELECT 1 FROM player_game_asterisks pga WHERE pga.game_id = g.game_id
+    ))`
+
 // Retrieves the basic game statistics for a user
-func GetUserBasicStats(ctx context.Context, tx *sql.Tx, userID string) (*ProfileStats, error) {
+func GetUserBasicStats(ctx context.Context, tx *sql.Tx, userID string, filter StatsFilter) (*ProfileStats, error) {
 	querier := GetQuerier(tx)
 	logger := l.WithComponentAndSource(
 		l.GetLoggerFromContext(ctx),
 		statsComponent,
 		"GetUserBasicStats",
-	).With().Str(l.UserIDKey, userID).Logger()
+	).With().Str(l.UserIDKey, userID).Interface("stats_filter", filter).Logger()
 
 	profStats := &ProfileStats{}
 
@@ -31,10 +47,11 @@ func GetUserBasicStats(ctx context.Context, tx *sql.Tx, userID string) (*Profile
   SELECT COUNT(DISTINCT g.game_id)
   FROM games g
   JOIN game_players gp ON g.game_id = gp.game_id
-  WHERE gp.user_id = $1 AND g.status = 'completed';
+  WHERE gp.user_id = $1 AND g.status = 'completed'
+    AND ($2 = '' OR g.ruleset = $2)` + excludeAsteriskedGamesCondition + `;
   `
 	logger.Debug().Str(l.QueryKey, queryGamesPlayed).Msg("Attempting to get total games played")
-	err := querier.QueryRowContext(ctx, queryGamesPlayed, userID).Scan(&profStats.TotalGamesPlayed)
+	err := querier.QueryRowContext(ctx, queryGamesPlayed, userID, filter.Ruleset, filter.ExcludeAsterisked).Scan(&profStats.TotalGamesPlayed)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to get total games played")
 		return nil, fmt.Errorf("error getting total games played for user %s: %w", userID, err)
@@ -44,19 +61,74 @@ func GetUserBasicStats(ctx context.Context, tx *sql.Tx, userID string) (*Profile
   SELECT COUNT(DISTINCT g.game_id)
   FROM games g
   JOIN game_players gp ON g.game_id = gp.game_id
-  WHERE gp.user_id = $1 AND g.status = 'completed' AND gp.finishing_position = 1;
+  WHERE gp.user_id = $1 AND g.status = 'completed' AND gp.finishing_position = 1
+    AND ($2 = '' OR g.ruleset = $2)` + excludeAsteriskedGamesCondition + `;
   `
 	logger.Debug().Str(l.QueryKey, queryTotalWins).Msg("Attempting to get total wins")
-	err = querier.QueryRowContext(ctx, queryTotalWins, userID).Scan(&profStats.TotalWins)
+	err = querier.QueryRowContext(ctx, queryTotalWins, userID, filter.Ruleset, filter.ExcludeAsterisked).Scan(&profStats.TotalWins)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to get total wins")
 		return nil, fmt.Errorf("error getting total wins for user %s: %w", userID, err)
 	}
 
+	profStats.BonusCounts, err = GetUserBonusCounts(ctx, tx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Info().Interface("base_profile_stats", profStats).Msg("User basic stats retrieved successfully")
 	return profStats, nil
 }
 
+// Counts the bonuses a user has captured in completed games, keyed by bonus type. A loot
+// alliance counts for both players in the alliance.
+func GetUserBonusCounts(ctx context.Context, tx *sql.Tx, userID string, filter StatsFilter) (map[string]int, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		statsComponent,
+		"GetUserBonusCounts",
+	).With().Str(l.UserIDKey, userID).Interface("stats_filter", filter).Logger()
+
+	query := `
+  SELECT rbe.bonus_type, COUNT(*)
+  FROM round_bonus_events rbe
+  JOIN game_players gp
+    ON gp.game_player_id = rbe.game_player_id OR gp.game_player_id = rbe.ally_game_player_id
+  JOIN games g ON g.game_id = gp.game_id
+  WHERE gp.user_id = $1 AND g.status = 'completed'
+    AND ($2 = '' OR g.ruleset = $2)` + excludeAsteriskedGamesCondition + `
+  GROUP BY rbe.bonus_type;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get bonus counts for user")
+
+	rows, err := querier.QueryContext(ctx, query, userID, filter.Ruleset, filter.ExcludeAsterisked)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to query bonus counts for user")
+		return nil, fmt.Errorf("error querying bonus counts for user %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var bonusType string
+		var count int
+		if err := rows.Scan(&bonusType, &count); err != nil {
+			logger.Error().Err(err).Msg("Failed to scan bonus count row")
+			return nil, fmt.Errorf("error scanning bonus count row for user %s: %w", userID, err)
+		}
+		counts[bonusType] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error().Err(err).Msg("Error iterating over bonus count rows")
+		return nil, fmt.Errorf("error iterating bonus count rows for user %s: %w", userID, err)
+	}
+
+	logger.Info().Int(l.CountKey, len(counts)).Msg("Bonus counts for user retrieved successfully")
+	return counts, nil
+}
+
 type SiteWideSummaryStats struct {
 	TotalPlayers      int
 	SessionsThisMonth int
@@ -86,7 +158,7 @@ func GetSiteWideSummaryStats(ctx context.Context, tx *sql.Tx) (*SiteWideSummaryS
 
 	querySessionsLastMonth := `
   SELECT COUNT(*) FROM game_sessions
-  WHERE created_at >= $1 AND created_at <= $2;
+  WHERE created_at >= $1 AND created_at <= $2 AND status <> 'abandoned';
   `
 	logger.Debug().Str(l.QueryKey, querySessionsLastMonth).Msg("Attempting to get sessions this month")
 	if err := querier.QueryRowContext(
@@ -101,7 +173,7 @@ func GetSiteWideSummaryStats(ctx context.Context, tx *sql.Tx) (*SiteWideSummaryS
 
 	queryGamesLastMonth := `
   SELECT COUNT(*) FROM games
-  WHERE created_at >= $1 AND created_at <= $2;
+  WHERE created_at >= $1 AND created_at <= $2 AND status <> 'abandoned';
   `
 	logger.Debug().Str(l.QueryKey, queryGamesLastMonth).Msg("Attempting to get games this month")
 	if err := querier.QueryRowContext(
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	l "github.com/seankim658/skullking/internal/logger"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const syncComponent = "database-sync"
+
+const syncActionColumns = `
+    game_id, client_action_id, action_type, status, reason, applied_by_user_id, created_at
+`
+
+// Retrieves a client action already recorded for a game, returns `ErrSyncActionNotFound` if
+// the action hasn't been seen before
+func GetSyncAction(ctx context.Context, tx *sql.Tx, gameID, clientActionID string) (*dbModels.SyncAction, error) {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		syncComponent,
+		"GetSyncAction",
+	).With().Str(l.GameIDKey, gameID).Str(l.ClientActionIDKey, clientActionID).Logger()
+
+	query := `
+  SELECT` + syncActionColumns + `
+  FROM sync_actions
+  WHERE game_id = $1 AND client_action_id = $2;
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to get sync action")
+
+	action, err := scanSyncAction(querier.QueryRowContext(ctx, query, gameID, clientActionID))
+	if err != nil {
+		if errors.Is(err, ErrSyncActionNotFound) {
+			logger.Debug().Msg("Sync action has not been recorded")
+		} else {
+			logger.Error().Err(err).Msg("Failed to get sync action")
+		}
+		return nil, err
+	}
+
+	logger.Info().Str(l.StatusKey, action.Status).Msg("Sync action retrieved successfully")
+	return action, nil
+}
+
+// Records the outcome of a client action applied to a game, replacing the outcome of an earlier
+// attempt that was rejected. Returns `ErrSyncActionAlreadyExists` if the action was already
+// applied to the game.
+func RecordSyncAction(
+	ctx context.Context,
+	tx *sql.Tx,
+	gameID, clientActionID, actionType, status, reason, appliedByUserID string,
+) error {
+	querier := GetQuerier(tx)
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		syncComponent,
+		"RecordSyncAction",
+	).With().
+		Str(l.GameIDKey, gameID).
+		Str(l.ClientActionIDKey, clientActionID).
+		Str(l.ActionTypeKey, actionType).
+		Str(l.StatusKey, status).
+		Logger()
+
+	query := `
+  INSERT INTO sync_actions (game_id, client_action_id, action_type, status, reason, applied_by_user_id)
+  VALUES ($1, $2, $3, $4, $5, $6)
+  ON CONFLICT (game_id, client_action_id) DO UPDATE
+  SET action_type = EXCLUDED.action_type,
+      status = EXCLUDED.status,
+      reason = EXCLUDED.reason,
+      applied_by_user_id = EXCLUDED.applied_by_user_id,
+      created_at = NOW()
+  WHERE sync_actions.status = 'rejected';
+  `
+	logger.Debug().Str(l.QueryKey, query).Msg("Attempting to record sync action")
+
+	result, err := querier.ExecContext(ctx, query,
+		gameID,
+		clientActionID,
+		actionType,
+		status,
+		NullString(reason),
+		NullString(appliedByUserID),
+	)
+	if err != nil {
+		constraintMappings := map[string]error{
+			"sync_actions_pkey": ErrSyncActionAlreadyExists,
+		}
+		handled, appErr := HandlePgError(err, logger, constraintMappings)
+		if handled {
+			return appErr
+		}
+		logger.Error().Err(err).Msg("Failed to record sync action")
+		return fmt.Errorf("error recording sync action %s for game %s: %w", clientActionID, gameID, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to get rows affected after recording sync action")
+		return fmt.Errorf("error checking rows affected for sync action %s: %w", clientActionID, err)
+	}
+	if rowsAffected == 0 {
+		logger.Warn().Msg("Sync action was already applied")
+		return ErrSyncActionAlreadyExists
+	}
+
+	logger.Info().Msg("Sync action recorded successfully")
+	return nil
+}
+
+// Sets a savepoint in a transaction so the changes after it can be undone on their own
+func Savepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("error setting savepoint %s: %w", name, err)
+	}
+	return nil
+}
+
+// Undoes the changes made in a transaction since a savepoint, keeping the savepoint
+func RollbackToSavepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("error rolling back to savepoint %s: %w", name, err)
+	}
+	return nil
+}
+
+// Releases a savepoint, keeping the changes made since it in the transaction
+func ReleaseSavepoint(ctx context.Context, tx *sql.Tx, name string) error {
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("error releasing savepoint %s: %w", name, err)
+	}
+	return nil
+}
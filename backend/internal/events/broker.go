@@ -0,0 +1,80 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	l "github.com/seankim658/skullking/internal/logger"
+	apiModels "github.com/seankim658/skullking/internal/models/api"
+)
+
+const brokerComponent = "events-broker"
+
+// Events buffered per subscriber before new events are dropped for it
+const subscriberBufferSize = 32
+
+// In-process broadcaster of game events, keyed by game ID. Only clients connected to this
+// server instance receive the events.
+type Broker struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan apiModels.GameEvent]struct{}
+}
+
+// The broker handlers publish committed game changes to
+// TODO : Move to a shared pub/sub (e.g. Postgres LISTEN/NOTIFY) if the API runs on more than one instance
+var Games = NewBroker()
+
+func NewBroker() *Broker {
+	return &Broker{subscribers: make(map[string]map[chan apiModels.GameEvent]struct{})}
+}
+
+// Registers a subscriber for a game's events. The returned function unsubscribes and must
+// be called once the subscriber stops reading, it closes the channel.
+func (b *Broker) Subscribe(gameID string) (<-chan apiModels.GameEvent, func()) {
+	ch := make(chan apiModels.GameEvent, subscriberBufferSize)
+
+	b.mu.Lock()
+	if b.subscribers[gameID] == nil {
+		b.subscribers[gameID] = make(map[chan apiModels.GameEvent]struct{})
+	}
+	b.subscribers[gameID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers[gameID], ch)
+			if len(b.subscribers[gameID]) == 0 {
+				delete(b.subscribers, gameID)
+			}
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+	return ch, unsubscribe
+}
+
+// Sends an event to every subscriber of the game without blocking. Subscribers whose buffer
+// is full miss the event, they can catch up by fetching the game.
+func (b *Broker) Publish(ctx context.Context, gameID string, event apiModels.GameEvent) {
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		brokerComponent,
+		"Publish",
+	).With().Str(l.GameIDKey, gameID).Str(l.EventTypeKey, event.Type).Logger()
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	delivered := 0
+	for ch := range b.subscribers[gameID] {
+		select {
+		case ch <- event:
+			delivered++
+		default:
+			logger.Warn().Msg("Subscriber buffer full, dropping game event")
+		}
+	}
+	logger.Debug().Int(l.CountKey, delivered).Msg("Game event published")
+}
@@ -0,0 +1,71 @@
+package games
+
+import "errors"
+
+var (
+	ErrNoActivePlayers = errors.New("no active players at the table")
+	ErrSeatNotFound    = errors.New("player is not seated at the table")
+)
+
+// A player's seat at the table, players who left the game mid-way stay seated but are
+// marked inactive so the rotation can skip them
+type Seat struct {
+	GamePlayerID string
+	SeatingOrder int
+	Active       bool
+}
+
+// Returns the first active player clockwise after the given player. Seats must be ordered
+// by seating order.
+func NextActiveSeat(seats []Seat, afterGamePlayerID string) (string, error) {
+	start := -1
+	for i, seat := range seats {
+		if seat.GamePlayerID == afterGamePlayerID {
+			start = i
+			break
+		}
+	}
+	if start == -1 {
+		return "", ErrSeatNotFound
+	}
+
+	for offset := 1; offset <= len(seats); offset++ {
+		seat := seats[(start+offset)%len(seats)]
+		if seat.Active {
+			return seat.GamePlayerID, nil
+		}
+	}
+	return "", ErrNoActivePlayers
+}
+
+// Determines the dealer for a new round. The first round is dealt by the starting dealer,
+// every later round passes the deal clockwise from the previous round's dealer. Players who
+// have left the game are skipped.
+func DealerForRound(seats []Seat, startingDealerID, previousDealerID string) (string, error) {
+	if previousDealerID != "" {
+		return NextActiveSeat(seats, previousDealerID)
+	}
+	for _, seat := range seats {
+		if seat.GamePlayerID == startingDealerID && seat.Active {
+			return seat.GamePlayerID, nil
+		}
+	}
+	return NextActiveSeat(seats, startingDealerID)
+}
+
+// Returns the next active player who still has to bid, starting from the dealer's left and
+// going clockwise. Returns an empty string once every active player has bid.
+func NextBidder(seats []Seat, dealerID string, hasBid map[string]bool) (string, error) {
+	current := dealerID
+	for range seats {
+		next, err := NextActiveSeat(seats, current)
+		if err != nil {
+			return "", err
+		}
+		if !hasBid[next] {
+			return next, nil
+		}
+		current = next
+	}
+	return "", nil
+}
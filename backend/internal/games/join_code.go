@@ -0,0 +1,36 @@
+package games
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Length of a game's join code
+const JoinCodeLength = 6
+
+// Characters a join code is made of, leaving out ones that are easily mistaken for each other
+// when read aloud or typed, like O and 0 or I, L and 1
+const joinCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+// Generates a random join code
+func GenerateJoinCode() (string, error) {
+	alphabetSize := big.NewInt(int64(len(joinCodeAlphabet)))
+	var code strings.Builder
+	code.Grow(JoinCodeLength)
+	for range JoinCodeLength {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("error generating join code: %w", err)
+		}
+		code.WriteByte(joinCodeAlphabet[n.Int64()])
+	}
+	return code.String(), nil
+}
+
+// Normalizes a join code as a player entered it, ignoring case, spaces and dashes
+func NormalizeJoinCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer(" ", "", "-", "").Replace(code)
+}
@@ -0,0 +1,88 @@
+package games
+
+import "sort"
+
+// House rules for resolving a tie for first place
+const (
+	// Tied players share first place
+	TiebreakerRuleSharedVictory = "shared_victory"
+	// Tied players play extra tiebreaker rounds until a single winner emerges
+	TiebreakerRuleTiebreakerRound = "tiebreaker_round"
+)
+
+// Validates the tiebreaker rule is a supported value
+func IsValidTiebreakerRule(rule string) bool {
+	switch rule {
+	case TiebreakerRuleSharedVictory, TiebreakerRuleTiebreakerRound:
+		return true
+	default:
+		return false
+	}
+}
+
+// A player's total score at the end of a game
+type Standing struct {
+	GamePlayerID string
+	Score        int
+	// Scores from each tiebreaker round the player took part in, in round order. These
+	// only break ties and never count towards the total score.
+	TiebreakScores []int
+}
+
+// A standing with its finishing position
+type RankedStanding struct {
+	Standing
+	Position int
+}
+
+// Orders standings from highest to lowest score and assigns finishing positions using
+// standard competition ranking, tied players share a position and the following position
+// is skipped (1, 2, 2, 4). Players level on score are separated by their tiebreaker round
+// scores when they have any.
+func RankStandings(standings []Standing) []RankedStanding {
+	ranked := make([]RankedStanding, 0, len(standings))
+	for _, s := range standings {
+		ranked = append(ranked, RankedStanding{Standing: s})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return compareStandings(ranked[i].Standing, ranked[j].Standing) > 0
+	})
+
+	for i := range ranked {
+		if i > 0 && compareStandings(ranked[i].Standing, ranked[i-1].Standing) == 0 {
+			ranked[i].Position = ranked[i-1].Position
+		} else {
+			ranked[i].Position = i + 1
+		}
+	}
+	return ranked
+}
+
+// Returns the IDs of the players sharing first place, empty if there is a single leader
+func TiedLeaders(ranked []RankedStanding) []string {
+	var leaders []string
+	for _, r := range ranked {
+		if r.Position == 1 {
+			leaders = append(leaders, r.GamePlayerID)
+		}
+	}
+	if len(leaders) < 2 {
+		return nil
+	}
+	return leaders
+}
+
+// Compares two standings, returning a positive number if a ranks above b, a negative number
+// if b ranks above a and zero if they are tied
+func compareStandings(a, b Standing) int {
+	if a.Score != b.Score {
+		return a.Score - b.Score
+	}
+	for i := 0; i < len(a.TiebreakScores) && i < len(b.TiebreakScores); i++ {
+		if a.TiebreakScores[i] != b.TiebreakScores[i] {
+			return a.TiebreakScores[i] - b.TiebreakScores[i]
+		}
+	}
+	// A player knocked out of an earlier tiebreaker played fewer tiebreaker rounds
+	return len(a.TiebreakScores) - len(b.TiebreakScores)
+}
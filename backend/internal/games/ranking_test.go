@@ -0,0 +1,130 @@
+package games
+
+import (
+	"reflect"
+	"testing"
+)
+
+// The player and position of each ranked standing, in order
+type rankedPosition struct {
+	GamePlayerID string
+	Position     int
+}
+
+func rankedPositions(ranked []RankedStanding) []rankedPosition {
+	var positions []rankedPosition
+	for _, r := range ranked {
+		positions = append(positions, rankedPosition{GamePlayerID: r.GamePlayerID, Position: r.Position})
+	}
+	return positions
+}
+
+func TestRankStandings(t *testing.T) {
+	tests := []struct {
+		name        string
+		standings   []Standing
+		want        []rankedPosition
+		wantLeaders []string
+	}{
+		{name: "no players"},
+		{
+			name:      "single player",
+			standings: []Standing{{GamePlayerID: "a", Score: -40}},
+			want:      []rankedPosition{{"a", 1}},
+		},
+		{
+			name: "distinct scores",
+			standings: []Standing{
+				{GamePlayerID: "a", Score: 120},
+				{GamePlayerID: "b", Score: 310},
+				{GamePlayerID: "c", Score: -20},
+			},
+			want: []rankedPosition{{"b", 1}, {"a", 2}, {"c", 3}},
+		},
+		{
+			name: "tie for second skips third",
+			standings: []Standing{
+				{GamePlayerID: "a", Score: 200},
+				{GamePlayerID: "b", Score: 150},
+				{GamePlayerID: "c", Score: 150},
+				{GamePlayerID: "d", Score: 90},
+			},
+			want: []rankedPosition{{"a", 1}, {"b", 2}, {"c", 2}, {"d", 4}},
+		},
+		{
+			name: "tie for first skips second",
+			standings: []Standing{
+				{GamePlayerID: "a", Score: 80},
+				{GamePlayerID: "b", Score: 250},
+				{GamePlayerID: "c", Score: 250},
+			},
+			want:        []rankedPosition{{"b", 1}, {"c", 1}, {"a", 3}},
+			wantLeaders: []string{"b", "c"},
+		},
+		{
+			name: "everyone tied",
+			standings: []Standing{
+				{GamePlayerID: "a", Score: 0},
+				{GamePlayerID: "b", Score: 0},
+				{GamePlayerID: "c", Score: 0},
+			},
+			want:        []rankedPosition{{"a", 1}, {"b", 1}, {"c", 1}},
+			wantLeaders: []string{"a", "b", "c"},
+		},
+		{
+			name: "tiebreaker round separates the leaders",
+			standings: []Standing{
+				{GamePlayerID: "a", Score: 250, TiebreakScores: []int{-20}},
+				{GamePlayerID: "b", Score: 250, TiebreakScores: []int{40}},
+				{GamePlayerID: "c", Score: 100},
+			},
+			want: []rankedPosition{{"b", 1}, {"a", 2}, {"c", 3}},
+		},
+		{
+			name: "tied tiebreaker round stays tied",
+			standings: []Standing{
+				{GamePlayerID: "a", Score: 250, TiebreakScores: []int{20}},
+				{GamePlayerID: "b", Score: 250, TiebreakScores: []int{20}},
+			},
+			want:        []rankedPosition{{"a", 1}, {"b", 1}},
+			wantLeaders: []string{"a", "b"},
+		},
+		{
+			name: "second tiebreaker round",
+			standings: []Standing{
+				{GamePlayerID: "a", Score: 250, TiebreakScores: []int{20, 10}},
+				{GamePlayerID: "b", Score: 250, TiebreakScores: []int{20, 30}},
+				{GamePlayerID: "c", Score: 250, TiebreakScores: []int{-10}},
+			},
+			want: []rankedPosition{{"b", 1}, {"a", 2}, {"c", 3}},
+		},
+		{
+			name: "knocked out of an earlier tiebreaker ranks below",
+			standings: []Standing{
+				{GamePlayerID: "a", Score: 250, TiebreakScores: []int{20}},
+				{GamePlayerID: "b", Score: 250, TiebreakScores: []int{20, 10}},
+			},
+			want: []rankedPosition{{"b", 1}, {"a", 2}},
+		},
+		{
+			name: "tiebreaker scores don't count towards the total",
+			standings: []Standing{
+				{GamePlayerID: "a", Score: 240, TiebreakScores: []int{90}},
+				{GamePlayerID: "b", Score: 250},
+			},
+			want: []rankedPosition{{"b", 1}, {"a", 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranked := RankStandings(tt.standings)
+			if got := rankedPositions(ranked); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RankStandings() = %v, want %v", got, tt.want)
+			}
+			if got := TiedLeaders(ranked); !reflect.DeepEqual(got, tt.wantLeaders) {
+				t.Errorf("TiedLeaders() = %v, want %v", got, tt.wantLeaders)
+			}
+		})
+	}
+}
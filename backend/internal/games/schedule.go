@@ -0,0 +1,76 @@
+package games
+
+import "errors"
+
+// Valid values for the `games.round_schedule` column, the round structures from the rulebook
+const (
+	// Rounds 1 to 10, dealing one more card each round
+	RoundScheduleStandard = "standard"
+	// Only the even rounds, 2, 4, 6, 8 and 10 cards
+	RoundScheduleEvenKeeled = "even_keeled"
+	// Skip the early rounds and play rounds 6 to 10
+	RoundScheduleSkipToTheBrawl = "skip_to_the_brawl"
+	// Five rounds of five cards
+	RoundScheduleSwiftNSalty = "swift_n_salty"
+	// Ten rounds of ten cards
+	RoundScheduleBroadsideBarrage = "broadside_barrage"
+)
+
+var (
+	ErrUnknownRoundSchedule = errors.New("unknown round schedule")
+	ErrRoundNotInSchedule   = errors.New("round number is outside the game's round schedule")
+)
+
+// Cards dealt to each player per round for every schedule, index 0 is round 1
+var roundSchedules = map[string][]int{
+	RoundScheduleStandard:         {1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+	RoundScheduleEvenKeeled:       {2, 4, 6, 8, 10},
+	RoundScheduleSkipToTheBrawl:   {6, 7, 8, 9, 10},
+	RoundScheduleSwiftNSalty:      {5, 5, 5, 5, 5},
+	RoundScheduleBroadsideBarrage: {10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
+}
+
+// Reports whether the round schedule is a supported value
+func IsValidRoundSchedule(schedule string) bool {
+	_, ok := roundSchedules[schedule]
+	return ok
+}
+
+// Returns the cards dealt to each player in every round of a schedule, index 0 is round 1
+func CardsPerRound(schedule string) ([]int, error) {
+	cards, ok := roundSchedules[schedule]
+	if !ok {
+		return nil, ErrUnknownRoundSchedule
+	}
+	return append([]int(nil), cards...), nil
+}
+
+// Returns the number of rounds in a schedule
+func RoundCount(schedule string) (int, error) {
+	cards, ok := roundSchedules[schedule]
+	if !ok {
+		return 0, ErrUnknownRoundSchedule
+	}
+	return len(cards), nil
+}
+
+// Returns the cards dealt to each player in a round of a schedule
+func CardsDealtForRound(schedule string, roundNumber int) (int, error) {
+	cards, ok := roundSchedules[schedule]
+	if !ok {
+		return 0, ErrUnknownRoundSchedule
+	}
+	if roundNumber < 1 || roundNumber > len(cards) {
+		return 0, ErrRoundNotInSchedule
+	}
+	return cards[roundNumber-1], nil
+}
+
+// Returns the cards dealt in a tiebreaker round, played with the hand size of the final round
+func TiebreakerCardsDealt(schedule string) (int, error) {
+	cards, ok := roundSchedules[schedule]
+	if !ok {
+		return 0, ErrUnknownRoundSchedule
+	}
+	return cards[len(cards)-1], nil
+}
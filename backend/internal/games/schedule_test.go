@@ -0,0 +1,113 @@
+package games
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCardsPerRound(t *testing.T) {
+	tests := []struct {
+		schedule string
+		want     []int
+		wantErr  error
+	}{
+		{schedule: RoundScheduleStandard, want: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{schedule: RoundScheduleEvenKeeled, want: []int{2, 4, 6, 8, 10}},
+		{schedule: RoundScheduleSkipToTheBrawl, want: []int{6, 7, 8, 9, 10}},
+		{schedule: RoundScheduleSwiftNSalty, want: []int{5, 5, 5, 5, 5}},
+		{schedule: RoundScheduleBroadsideBarrage, want: []int{10, 10, 10, 10, 10, 10, 10, 10, 10, 10}},
+		{schedule: "marathon", wantErr: ErrUnknownRoundSchedule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			got, err := CardsPerRound(tt.schedule)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CardsPerRound() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CardsPerRound() = %v, want %v", got, tt.want)
+			}
+
+			count, err := RoundCount(tt.schedule)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RoundCount() error = %v, want %v", err, tt.wantErr)
+			}
+			if count != len(tt.want) {
+				t.Errorf("RoundCount() = %d, want %d", count, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestCardsPerRoundReturnsCopy(t *testing.T) {
+	cards, err := CardsPerRound(RoundScheduleStandard)
+	if err != nil {
+		t.Fatalf("CardsPerRound() error = %v", err)
+	}
+	cards[0] = 99
+
+	if got, _ := CardsDealtForRound(RoundScheduleStandard, 1); got != 1 {
+		t.Errorf("CardsDealtForRound() = %d after modifying the returned schedule, want 1", got)
+	}
+}
+
+func TestCardsDealtForRound(t *testing.T) {
+	tests := []struct {
+		name        string
+		schedule    string
+		roundNumber int
+		want        int
+		wantErr     error
+	}{
+		{name: "standard first round", schedule: RoundScheduleStandard, roundNumber: 1, want: 1},
+		{name: "standard last round", schedule: RoundScheduleStandard, roundNumber: 10, want: 10},
+		{name: "even keeled third round", schedule: RoundScheduleEvenKeeled, roundNumber: 3, want: 6},
+		{name: "skip to the brawl first round", schedule: RoundScheduleSkipToTheBrawl, roundNumber: 1, want: 6},
+		{name: "swift n salty last round", schedule: RoundScheduleSwiftNSalty, roundNumber: 5, want: 5},
+		{name: "broadside barrage first round", schedule: RoundScheduleBroadsideBarrage, roundNumber: 1, want: 10},
+		{name: "round zero", schedule: RoundScheduleStandard, roundNumber: 0, wantErr: ErrRoundNotInSchedule},
+		{name: "past the last round", schedule: RoundScheduleEvenKeeled, roundNumber: 6, wantErr: ErrRoundNotInSchedule},
+		{name: "unknown schedule", schedule: "marathon", roundNumber: 1, wantErr: ErrUnknownRoundSchedule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CardsDealtForRound(tt.schedule, tt.roundNumber)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CardsDealtForRound() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CardsDealtForRound() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTiebreakerCardsDealt(t *testing.T) {
+	tests := []struct {
+		schedule string
+		want     int
+		wantErr  error
+	}{
+		{schedule: RoundScheduleStandard, want: 10},
+		{schedule: RoundScheduleEvenKeeled, want: 10},
+		{schedule: RoundScheduleSkipToTheBrawl, want: 10},
+		{schedule: RoundScheduleSwiftNSalty, want: 5},
+		{schedule: RoundScheduleBroadsideBarrage, want: 10},
+		{schedule: "marathon", wantErr: ErrUnknownRoundSchedule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			got, err := TiebreakerCardsDealt(tt.schedule)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TiebreakerCardsDealt() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("TiebreakerCardsDealt() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
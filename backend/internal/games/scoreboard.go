@@ -0,0 +1,56 @@
+package games
+
+import "sort"
+
+// A player's score in a single scored round
+type PlayerRoundScore struct {
+	GamePlayerID string
+	RoundNumber  int
+	Score        int
+}
+
+// A player's score for a round along with their total after it
+type RunningScore struct {
+	RoundNumber  int
+	RoundScore   int
+	RunningTotal int
+}
+
+// A player's row of the scoreboard
+type ScoreboardRow struct {
+	GamePlayerID string
+	Rounds       []RunningScore
+	Total        int
+}
+
+// Builds the running totals of every player, one row per player in the order given. Scores
+// are accumulated in round order and rounds a player has no score for are left out of their
+// row, e.g. rounds after they left the game.
+func BuildScoreboard(gamePlayerIDs []string, scores []PlayerRoundScore) []ScoreboardRow {
+	rows := make([]ScoreboardRow, len(gamePlayerIDs))
+	rowIndex := make(map[string]int, len(gamePlayerIDs))
+	for i, gamePlayerID := range gamePlayerIDs {
+		rows[i] = ScoreboardRow{GamePlayerID: gamePlayerID, Rounds: []RunningScore{}}
+		rowIndex[gamePlayerID] = i
+	}
+
+	ordered := make([]PlayerRoundScore, len(scores))
+	copy(ordered, scores)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].RoundNumber < ordered[j].RoundNumber
+	})
+
+	for _, score := range ordered {
+		i, ok := rowIndex[score.GamePlayerID]
+		if !ok {
+			continue
+		}
+		rows[i].Total += score.Score
+		rows[i].Rounds = append(rows[i].Rounds, RunningScore{
+			RoundNumber:  score.RoundNumber,
+			RoundScore:   score.Score,
+			RunningTotal: rows[i].Total,
+		})
+	}
+	return rows
+}
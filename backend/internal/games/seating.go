@@ -0,0 +1,79 @@
+package games
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+)
+
+// Player count limits for a game of Skull King
+const (
+	MinPlayers = 2
+	MaxPlayers = 8
+)
+
+var (
+	ErrTooFewPlayers  = errors.New("not enough players to start the game")
+	ErrTooManyPlayers = errors.New("too many players to start the game")
+)
+
+// Checks the number of players is within the limits of the game
+func ValidatePlayerCount(playerCount int) error {
+	if playerCount < MinPlayers {
+		return ErrTooFewPlayers
+	}
+	if playerCount > MaxPlayers {
+		return ErrTooManyPlayers
+	}
+	return nil
+}
+
+// Returns a new random seating arrangement of the given game player IDs, the player at
+// index 0 takes seating order 1 and so on clockwise around the table
+func ShuffleSeating(gamePlayerIDs []string) []string {
+	shuffled := make([]string, len(gamePlayerIDs))
+	copy(shuffled, gamePlayerIDs)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+// Picks a random starting dealer from the given game player IDs
+func PickStartingDealer(gamePlayerIDs []string) string {
+	if len(gamePlayerIDs) == 0 {
+		return ""
+	}
+	return gamePlayerIDs[rand.IntN(len(gamePlayerIDs))]
+}
+
+// Validates a full seating arrangement: every player in the game has exactly one seat and the
+// seats run from 1 to the number of players with none shared
+func ValidateSeating(seats []PlayerEntry, players map[string]bool) []EntryError {
+	errs := validateEntries(seats, players, FieldSeatingOrder, "A seat", "Player is not in this game")
+
+	seatCount := len(players)
+	takenBy := make(map[int]string, len(seats))
+	for _, seat := range seats {
+		if !players[seat.GamePlayerID] {
+			continue
+		}
+		switch {
+		case seat.Value < 1 || seat.Value > seatCount:
+			errs = append(errs, EntryError{
+				GamePlayerID: seat.GamePlayerID,
+				Field:        FieldSeatingOrder,
+				Message:      fmt.Sprintf("Seating order must be between 1 and the %d players", seatCount),
+			})
+		case takenBy[seat.Value] != "" && takenBy[seat.Value] != seat.GamePlayerID:
+			errs = append(errs, EntryError{
+				GamePlayerID: seat.GamePlayerID,
+				Field:        FieldSeatingOrder,
+				Message:      fmt.Sprintf("Seat %d is already taken by another player", seat.Value),
+			})
+		default:
+			takenBy[seat.Value] = seat.GamePlayerID
+		}
+	}
+	return errs
+}
@@ -0,0 +1,98 @@
+package games
+
+import (
+	"sort"
+	"time"
+)
+
+// Kinds of events in a game's timeline
+const (
+	TimelinePlayerAdded    = "player_added"
+	TimelineGameStarted    = "game_started"
+	TimelineRoundStarted   = "round_started"
+	TimelineBidsSubmitted  = "bids_submitted"
+	TimelineBidPlaced      = "bid_placed" // A player entered their own bid from their device
+	TimelineRoundCompleted = "round_completed"
+	TimelineBonusRecorded  = "bonus_recorded"
+	TimelineScoreCorrected = "score_corrected"
+	// A round moved back a step or was removed by an undo
+	TimelineRoundUndone   = "round_undone"
+	TimelineGameCompleted = "game_completed"
+	TimelineGameAbandoned = "game_abandoned"
+)
+
+// Something that happened in a game
+type TimelineEvent struct {
+	Type       string
+	OccurredAt time.Time
+	// Zero for events that aren't about a round, or once the round has been removed
+	RoundNumber int
+	// Players the event is about, e.g. the player added or the captor of a bonus
+	GamePlayerIDs []string
+	BonusType     string
+	// For bids, results and corrections, the field recorded and each player's new value
+	Field   string
+	Entries []PlayerEntry
+}
+
+// A player's score for a round changing at a point in time
+type RoundScoreChange struct {
+	RoundID      string
+	GamePlayerID string
+	Score        int
+	ChangedAt    time.Time
+}
+
+// A timeline event with the standings of the players in the game once it happened
+type TimelineEntry struct {
+	TimelineEvent
+	Standings []RankedStanding
+}
+
+// Orders events by when they happened, events at the same time keep the order given, and
+// attaches the standings after each one. A player's score after an event is the total of their
+// latest score for each round changed up to that time. Only players added by then are ranked.
+func BuildTimeline(events []TimelineEvent, scoreChanges []RoundScoreChange) []TimelineEntry {
+	orderedEvents := make([]TimelineEvent, len(events))
+	copy(orderedEvents, events)
+	sort.SliceStable(orderedEvents, func(i, j int) bool {
+		return orderedEvents[i].OccurredAt.Before(orderedEvents[j].OccurredAt)
+	})
+	orderedChanges := make([]RoundScoreChange, len(scoreChanges))
+	copy(orderedChanges, scoreChanges)
+	sort.SliceStable(orderedChanges, func(i, j int) bool {
+		return orderedChanges[i].ChangedAt.Before(orderedChanges[j].ChangedAt)
+	})
+
+	type roundPlayer struct{ roundID, gamePlayerID string }
+	roundScores := make(map[roundPlayer]int)
+	totals := make(map[string]int)
+	var players []string
+	added := make(map[string]bool)
+
+	timeline := make([]TimelineEntry, 0, len(orderedEvents))
+	next := 0
+	for _, event := range orderedEvents {
+		for ; next < len(orderedChanges) && !orderedChanges[next].ChangedAt.After(event.OccurredAt); next++ {
+			change := orderedChanges[next]
+			key := roundPlayer{change.RoundID, change.GamePlayerID}
+			totals[change.GamePlayerID] += change.Score - roundScores[key]
+			roundScores[key] = change.Score
+		}
+		if event.Type == TimelinePlayerAdded {
+			for _, gamePlayerID := range event.GamePlayerIDs {
+				if !added[gamePlayerID] {
+					added[gamePlayerID] = true
+					players = append(players, gamePlayerID)
+				}
+			}
+		}
+
+		standings := make([]Standing, 0, len(players))
+		for _, gamePlayerID := range players {
+			standings = append(standings, Standing{GamePlayerID: gamePlayerID, Score: totals[gamePlayerID]})
+		}
+		timeline = append(timeline, TimelineEntry{TimelineEvent: event, Standings: RankStandings(standings)})
+	}
+	return timeline
+}
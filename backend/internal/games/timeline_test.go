@@ -0,0 +1,154 @@
+package games
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// A timeline entry's event type and the standings after it, in rank order
+type timelineStep struct {
+	Type      string
+	Standings []rankedScore
+}
+
+type rankedScore struct {
+	GamePlayerID string
+	Score        int
+	Position     int
+}
+
+func timelineSteps(timeline []TimelineEntry) []timelineStep {
+	var steps []timelineStep
+	for _, entry := range timeline {
+		step := timelineStep{Type: entry.Type}
+		for _, s := range entry.Standings {
+			step.Standings = append(step.Standings, rankedScore{s.GamePlayerID, s.Score, s.Position})
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+func TestBuildTimeline(t *testing.T) {
+	start := time.Date(2024, time.March, 2, 19, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+
+	tests := []struct {
+		name         string
+		events       []TimelineEvent
+		scoreChanges []RoundScoreChange
+		want         []timelineStep
+	}{
+		{name: "no events"},
+		{
+			name: "players are only ranked once added",
+			events: []TimelineEvent{
+				{Type: TimelinePlayerAdded, OccurredAt: at(0), GamePlayerIDs: []string{"a"}},
+				{Type: TimelinePlayerAdded, OccurredAt: at(1), GamePlayerIDs: []string{"b"}},
+				{Type: TimelineGameStarted, OccurredAt: at(2)},
+			},
+			want: []timelineStep{
+				{Type: TimelinePlayerAdded, Standings: []rankedScore{{"a", 0, 1}}},
+				{Type: TimelinePlayerAdded, Standings: []rankedScore{{"a", 0, 1}, {"b", 0, 1}}},
+				{Type: TimelineGameStarted, Standings: []rankedScore{{"a", 0, 1}, {"b", 0, 1}}},
+			},
+		},
+		{
+			name: "events are ordered by time and keep their order when simultaneous",
+			events: []TimelineEvent{
+				{Type: TimelineRoundCompleted, OccurredAt: at(10), RoundNumber: 1},
+				{Type: TimelinePlayerAdded, OccurredAt: at(0), GamePlayerIDs: []string{"a", "b"}},
+				{Type: TimelineBonusRecorded, OccurredAt: at(10), RoundNumber: 1},
+				{Type: TimelineRoundStarted, OccurredAt: at(5), RoundNumber: 1},
+			},
+			want: []timelineStep{
+				{Type: TimelinePlayerAdded, Standings: []rankedScore{{"a", 0, 1}, {"b", 0, 1}}},
+				{Type: TimelineRoundStarted, Standings: []rankedScore{{"a", 0, 1}, {"b", 0, 1}}},
+				{Type: TimelineRoundCompleted, Standings: []rankedScore{{"a", 0, 1}, {"b", 0, 1}}},
+				{Type: TimelineBonusRecorded, Standings: []rankedScore{{"a", 0, 1}, {"b", 0, 1}}},
+			},
+		},
+		{
+			name: "scores add up across rounds",
+			events: []TimelineEvent{
+				{Type: TimelinePlayerAdded, OccurredAt: at(0), GamePlayerIDs: []string{"a", "b"}},
+				{Type: TimelineRoundCompleted, OccurredAt: at(10), RoundNumber: 1},
+				{Type: TimelineRoundCompleted, OccurredAt: at(20), RoundNumber: 2},
+			},
+			scoreChanges: []RoundScoreChange{
+				{RoundID: "r1", GamePlayerID: "a", Score: 20, ChangedAt: at(10)},
+				{RoundID: "r1", GamePlayerID: "b", Score: -10, ChangedAt: at(10)},
+				{RoundID: "r2", GamePlayerID: "a", Score: -20, ChangedAt: at(20)},
+				{RoundID: "r2", GamePlayerID: "b", Score: 40, ChangedAt: at(20)},
+			},
+			want: []timelineStep{
+				{Type: TimelinePlayerAdded, Standings: []rankedScore{{"a", 0, 1}, {"b", 0, 1}}},
+				{Type: TimelineRoundCompleted, Standings: []rankedScore{{"a", 20, 1}, {"b", -10, 2}}},
+				{Type: TimelineRoundCompleted, Standings: []rankedScore{{"b", 30, 1}, {"a", 0, 2}}},
+			},
+		},
+		{
+			name: "a correction replaces the round's earlier score",
+			events: []TimelineEvent{
+				{Type: TimelinePlayerAdded, OccurredAt: at(0), GamePlayerIDs: []string{"a", "b"}},
+				{Type: TimelineRoundCompleted, OccurredAt: at(10), RoundNumber: 1},
+				{Type: TimelineScoreCorrected, OccurredAt: at(15), RoundNumber: 1},
+			},
+			scoreChanges: []RoundScoreChange{
+				{RoundID: "r1", GamePlayerID: "b", Score: 20, ChangedAt: at(10)},
+				{RoundID: "r1", GamePlayerID: "a", Score: 40, ChangedAt: at(10)},
+				{RoundID: "r1", GamePlayerID: "a", Score: -20, ChangedAt: at(15)},
+			},
+			want: []timelineStep{
+				{Type: TimelinePlayerAdded, Standings: []rankedScore{{"a", 0, 1}, {"b", 0, 1}}},
+				{Type: TimelineRoundCompleted, Standings: []rankedScore{{"a", 40, 1}, {"b", 20, 2}}},
+				{Type: TimelineScoreCorrected, Standings: []rankedScore{{"b", 20, 1}, {"a", -20, 2}}},
+			},
+		},
+		{
+			name: "score changes after the last event are left out",
+			events: []TimelineEvent{
+				{Type: TimelinePlayerAdded, OccurredAt: at(0), GamePlayerIDs: []string{"a"}},
+			},
+			scoreChanges: []RoundScoreChange{
+				{RoundID: "r1", GamePlayerID: "a", Score: 20, ChangedAt: at(10)},
+			},
+			want: []timelineStep{
+				{Type: TimelinePlayerAdded, Standings: []rankedScore{{"a", 0, 1}}},
+			},
+		},
+		{
+			name: "a player added twice is ranked once",
+			events: []TimelineEvent{
+				{Type: TimelinePlayerAdded, OccurredAt: at(0), GamePlayerIDs: []string{"a"}},
+				{Type: TimelinePlayerAdded, OccurredAt: at(1), GamePlayerIDs: []string{"a"}},
+			},
+			want: []timelineStep{
+				{Type: TimelinePlayerAdded, Standings: []rankedScore{{"a", 0, 1}}},
+				{Type: TimelinePlayerAdded, Standings: []rankedScore{{"a", 0, 1}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := timelineSteps(BuildTimeline(tt.events, tt.scoreChanges))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BuildTimeline() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildTimelineDoesNotReorderInput(t *testing.T) {
+	events := []TimelineEvent{
+		{Type: TimelineGameStarted, OccurredAt: time.Unix(20, 0)},
+		{Type: TimelinePlayerAdded, OccurredAt: time.Unix(10, 0)},
+	}
+	BuildTimeline(events, nil)
+
+	if events[0].Type != TimelineGameStarted {
+		t.Errorf("BuildTimeline() reordered the events passed in")
+	}
+}
@@ -0,0 +1,139 @@
+package games
+
+import (
+	"fmt"
+	"sort"
+)
+
+const notInRoundMessage = "Player is not taking part in this round"
+
+// Request fields that validation errors refer to
+const (
+	FieldGamePlayerID          = "game_player_id"
+	FieldBidAmount             = "bid_amount"
+	FieldTricksTaken           = "tricks_taken"
+	FieldKrakenDiscardedTricks = "kraken_discarded_tricks"
+	FieldSeatingOrder          = "seating_order"
+)
+
+// A single player's bid or tricks taken for a round
+type PlayerEntry struct {
+	GamePlayerID string
+	Value        int
+}
+
+// A problem with one field of a bid or trick submission. GamePlayerID is empty when the
+// problem is with the submission as a whole, such as trick totals.
+type EntryError struct {
+	GamePlayerID string
+	Field        string
+	Message      string
+}
+
+// Validates the bids for a round: every participant bids exactly once and each bid is
+// between 0 and the cards dealt
+func ValidateBids(bids []PlayerEntry, participants map[string]bool, cardsDealt int) []EntryError {
+	errs := validateEntries(bids, participants, FieldBidAmount, "A bid", notInRoundMessage)
+	for _, bid := range bids {
+		if participants[bid.GamePlayerID] && (bid.Value < 0 || bid.Value > cardsDealt) {
+			errs = append(errs, EntryError{
+				GamePlayerID: bid.GamePlayerID,
+				Field:        FieldBidAmount,
+				Message:      fmt.Sprintf("Bid must be between 0 and the %d cards dealt", cardsDealt),
+			})
+		}
+	}
+	return errs
+}
+
+// Validates the tricks taken in a round: every participant has exactly one result, each is
+// between 0 and the cards dealt, and together they add up to the tricks played. A trick
+// discarded by the Kraken or the White Whale is taken by nobody, so at most
+// `maxDiscardedTricks`, the number of those cards in the deck, can be discarded.
+func ValidateTricks(
+	tricks []PlayerEntry,
+	participants map[string]bool,
+	cardsDealt, discardedTricks, maxDiscardedTricks int,
+) []EntryError {
+	errs := validateEntries(tricks, participants, FieldTricksTaken, "Tricks taken", notInRoundMessage)
+
+	switch {
+	case discardedTricks != 0 && maxDiscardedTricks == 0:
+		errs = append(errs, EntryError{
+			Field:   FieldKrakenDiscardedTricks,
+			Message: "Neither the Kraken nor the White Whale is in play in this game",
+		})
+	case discardedTricks < 0 || discardedTricks > maxDiscardedTricks:
+		errs = append(errs, EntryError{
+			Field:   FieldKrakenDiscardedTricks,
+			Message: fmt.Sprintf("Discarded tricks must be between 0 and %d, one for each of the Kraken and White Whale in play", maxDiscardedTricks),
+		})
+	}
+
+	total := 0
+	for _, result := range tricks {
+		if !participants[result.GamePlayerID] {
+			continue
+		}
+		if result.Value < 0 || result.Value > cardsDealt {
+			errs = append(errs, EntryError{
+				GamePlayerID: result.GamePlayerID,
+				Field:        FieldTricksTaken,
+				Message:      fmt.Sprintf("Tricks taken must be between 0 and the %d cards dealt", cardsDealt),
+			})
+		}
+		total += result.Value
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if tricksPlayed := cardsDealt - discardedTricks; total != tricksPlayed {
+		errs = append(errs, EntryError{
+			Field:   FieldTricksTaken,
+			Message: fmt.Sprintf("Tricks taken add up to %d but %d tricks were won", total, tricksPlayed),
+		})
+	}
+	return errs
+}
+
+// Checks every entry is for a participant, no participant has more than one entry and every
+// participant has an entry. outsiderMessage describes an entry for someone who is not a
+// participant.
+func validateEntries(entries []PlayerEntry, participants map[string]bool, field, label, outsiderMessage string) []EntryError {
+	var errs []EntryError
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		switch {
+		case !participants[entry.GamePlayerID]:
+			errs = append(errs, EntryError{
+				GamePlayerID: entry.GamePlayerID,
+				Field:        FieldGamePlayerID,
+				Message:      outsiderMessage,
+			})
+		case seen[entry.GamePlayerID]:
+			errs = append(errs, EntryError{
+				GamePlayerID: entry.GamePlayerID,
+				Field:        field,
+				Message:      "Player has more than one entry",
+			})
+		}
+		seen[entry.GamePlayerID] = true
+	}
+
+	var missing []string
+	for gamePlayerID := range participants {
+		if !seen[gamePlayerID] {
+			missing = append(missing, gamePlayerID)
+		}
+	}
+	sort.Strings(missing)
+	for _, gamePlayerID := range missing {
+		errs = append(errs, EntryError{
+			GamePlayerID: gamePlayerID,
+			Field:        field,
+			Message:      label + " is required for this player",
+		})
+	}
+	return errs
+}
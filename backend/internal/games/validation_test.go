@@ -0,0 +1,188 @@
+package games
+
+import (
+	"reflect"
+	"testing"
+)
+
+// The player and field of each error, messages are left out so wording changes don't break tests
+type entryErrorKey struct {
+	GamePlayerID string
+	Field        string
+}
+
+func entryErrorKeys(errs []EntryError) []entryErrorKey {
+	var keys []entryErrorKey
+	for _, e := range errs {
+		keys = append(keys, entryErrorKey{GamePlayerID: e.GamePlayerID, Field: e.Field})
+	}
+	return keys
+}
+
+func entries(values ...any) []PlayerEntry {
+	var out []PlayerEntry
+	for i := 0; i < len(values); i += 2 {
+		out = append(out, PlayerEntry{GamePlayerID: values[i].(string), Value: values[i+1].(int)})
+	}
+	return out
+}
+
+func TestValidateBids(t *testing.T) {
+	participants := map[string]bool{"a": true, "b": true, "c": true}
+
+	tests := []struct {
+		name       string
+		bids       []PlayerEntry
+		cardsDealt int
+		want       []entryErrorKey
+	}{
+		{name: "valid", bids: entries("a", 1, "b", 0, "c", 3), cardsDealt: 3},
+		{name: "everyone bids zero", bids: entries("a", 0, "b", 0, "c", 0), cardsDealt: 1},
+		{name: "bids may add up to more than the cards dealt", bids: entries("a", 2, "b", 2, "c", 2), cardsDealt: 2},
+		{
+			name:       "bid above the cards dealt",
+			bids:       entries("a", 4, "b", 0, "c", 0),
+			cardsDealt: 3,
+			want:       []entryErrorKey{{"a", FieldBidAmount}},
+		},
+		{
+			name:       "negative bid",
+			bids:       entries("a", -1, "b", 0, "c", 0),
+			cardsDealt: 3,
+			want:       []entryErrorKey{{"a", FieldBidAmount}},
+		},
+		{
+			name:       "missing bids",
+			bids:       entries("b", 1),
+			cardsDealt: 3,
+			want:       []entryErrorKey{{"a", FieldBidAmount}, {"c", FieldBidAmount}},
+		},
+		{
+			name:       "duplicate bid",
+			bids:       entries("a", 1, "b", 0, "c", 0, "a", 2),
+			cardsDealt: 3,
+			want:       []entryErrorKey{{"a", FieldBidAmount}},
+		},
+		{
+			name:       "bid from outside the round is not range checked",
+			bids:       entries("a", 1, "b", 0, "c", 0, "d", 9),
+			cardsDealt: 3,
+			want:       []entryErrorKey{{"d", FieldGamePlayerID}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := entryErrorKeys(ValidateBids(tt.bids, participants, tt.cardsDealt))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBids() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTricks(t *testing.T) {
+	participants := map[string]bool{"a": true, "b": true, "c": true}
+
+	tests := []struct {
+		name               string
+		tricks             []PlayerEntry
+		cardsDealt         int
+		discardedTricks    int
+		maxDiscardedTricks int
+		want               []entryErrorKey
+	}{
+		{name: "valid", tricks: entries("a", 2, "b", 0, "c", 3), cardsDealt: 5},
+		{
+			name:       "tricks don't add up",
+			tricks:     entries("a", 2, "b", 0, "c", 2),
+			cardsDealt: 5,
+			want:       []entryErrorKey{{"", FieldTricksTaken}},
+		},
+		{
+			name:       "tricks add up to more than were played",
+			tricks:     entries("a", 2, "b", 2, "c", 2),
+			cardsDealt: 5,
+			want:       []entryErrorKey{{"", FieldTricksTaken}},
+		},
+		{
+			name:               "kraken discards a trick",
+			tricks:             entries("a", 2, "b", 0, "c", 2),
+			cardsDealt:         5,
+			discardedTricks:    1,
+			maxDiscardedTricks: 1,
+		},
+		{
+			name:               "kraken and white whale both discard a trick",
+			tricks:             entries("a", 1, "b", 1, "c", 1),
+			cardsDealt:         5,
+			discardedTricks:    2,
+			maxDiscardedTricks: 2,
+		},
+		{
+			name:               "every trick discarded",
+			tricks:             entries("a", 0, "b", 0, "c", 0),
+			cardsDealt:         1,
+			discardedTricks:    1,
+			maxDiscardedTricks: 1,
+		},
+		{
+			name:               "discarded trick still counted as taken",
+			tricks:             entries("a", 2, "b", 0, "c", 3),
+			cardsDealt:         5,
+			discardedTricks:    1,
+			maxDiscardedTricks: 2,
+			want:               []entryErrorKey{{"", FieldTricksTaken}},
+		},
+		{
+			name:            "discards without the kraken or white whale",
+			tricks:          entries("a", 2, "b", 0, "c", 2),
+			cardsDealt:      5,
+			discardedTricks: 1,
+			want:            []entryErrorKey{{"", FieldKrakenDiscardedTricks}},
+		},
+		{
+			name:               "more discards than discarding cards in play",
+			tricks:             entries("a", 1, "b", 1, "c", 1),
+			cardsDealt:         5,
+			discardedTricks:    2,
+			maxDiscardedTricks: 1,
+			want:               []entryErrorKey{{"", FieldKrakenDiscardedTricks}},
+		},
+		{
+			name:               "negative discards",
+			tricks:             entries("a", 2, "b", 0, "c", 3),
+			cardsDealt:         5,
+			discardedTricks:    -1,
+			maxDiscardedTricks: 2,
+			want:               []entryErrorKey{{"", FieldKrakenDiscardedTricks}},
+		},
+		{
+			name:       "tricks above the cards dealt skip the total check",
+			tricks:     entries("a", 6, "b", 0, "c", 0),
+			cardsDealt: 5,
+			want:       []entryErrorKey{{"a", FieldTricksTaken}},
+		},
+		{
+			name:       "negative tricks",
+			tricks:     entries("a", -1, "b", 3, "c", 3),
+			cardsDealt: 5,
+			want:       []entryErrorKey{{"a", FieldTricksTaken}},
+		},
+		{
+			name:       "missing result and outsider",
+			tricks:     entries("a", 3, "b", 2, "d", 0),
+			cardsDealt: 5,
+			want:       []entryErrorKey{{"d", FieldGamePlayerID}, {"c", FieldTricksTaken}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := entryErrorKeys(ValidateTricks(tt.tricks, participants, tt.cardsDealt, tt.discardedTricks, tt.maxDiscardedTricks))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateTricks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
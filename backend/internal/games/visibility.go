@@ -0,0 +1,21 @@
+package games
+
+// Valid values for the `games.visibility` column, who besides the participants can view a game
+const (
+	// Only the players, the creator and the scorekeeper
+	GameVisibilityParticipants = "participants"
+	// Also friends of any registered player in the game
+	GameVisibilityFriends = "friends"
+	// Any signed in user
+	GameVisibilityPublic = "public"
+)
+
+// Validates the game visibility is a supported value
+func IsValidGameVisibility(visibility string) bool {
+	switch visibility {
+	case GameVisibilityParticipants, GameVisibilityFriends, GameVisibilityPublic:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	cf "github.com/seankim658/skullking/internal/config"
+	db "github.com/seankim658/skullking/internal/database"
+	l "github.com/seankim658/skullking/internal/logger"
+	apiModels "github.com/seankim658/skullking/internal/models/api"
+	modelConverters "github.com/seankim658/skullking/internal/models/convert"
+)
+
+const asteriskHandlerComponent = "handlers-asterisk"
+
+type AsteriskHandler struct {
+	Cfg *cf.Config
+}
+
+func NewAsteriskHandler(cfg *cf.Config) *AsteriskHandler {
+	return &AsteriskHandler{Cfg: cfg}
+}
+
+// Handles marking a player's game with an asterisk, e.g. for leaving early or being coached
+// Path: /games/{game_id}/asterisks
+// Method: POST
+func (ah *AsteriskHandler) HandleAddAsterisk(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		asteriskHandlerComponent,
+		"HandleAddAsterisk",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	if _, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger); !authorized {
+		return
+	}
+
+	var req apiModels.CreateAsteriskRequest
+	if !ParseJSON(w, r, &req) {
+		return
+	}
+	if !RequireFields(w, r, map[string]string{"game_player_id": req.GamePlayerID}) {
+		return
+	}
+	reason, reasonOk := OptionalReason(w, r, req.Reason)
+	if !reasonOk {
+		return
+	}
+	logger = logger.With().Str(l.GamePlayerIDKey, req.GamePlayerID).Logger()
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for adding asterisk")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing asterisk")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Step 1: Lock the game and check the player is in it
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+	players, opErr := db.GetGamePlayersByGameID(ctx, tx, gameID)
+	if opErr != nil {
+		logger.Error().Err(opErr).Msg("Failed to fetch players for asterisk")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game players")
+		return
+	}
+	if _, found := findGamePlayer(players, req.GamePlayerID); !found {
+		opErr = db.ErrGamePlayerNotFound
+		ErrorResponse(w, r, http.StatusBadRequest, "Player is not in this game")
+		return
+	}
+
+	// Step 2: Add the asterisk
+	dbAsterisk, opErr := db.CreatePlayerGameAsterisk(ctx, tx, gameID, req.GamePlayerID, reason)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to add asterisk")
+		return
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for adding asterisk: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize adding asterisk")
+		return
+	}
+	committed = true
+
+	apiAsterisk, convErr := modelConverters.DBAsteriskToAPIAsterisk(dbAsterisk)
+	if convErr != nil {
+		logger.Error().Err(convErr).Msg("Failed to convert DB asterisk to API asterisk for response")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to process asterisk details")
+		return
+	}
+
+	setGameETag(ctx, w, gameID, logger)
+	Respond(w, r, http.StatusCreated, apiAsterisk, "Asterisk added successfully")
+}
+
+// Handles listing the asterisks recorded for a game
+// Path: /games/{game_id}/asterisks
+// Method: GET
+func (ah *AsteriskHandler) HandleGetAsterisks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		asteriskHandlerComponent,
+		"HandleGetAsterisks",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	if _, authorized := CheckGameViewAccess(ctx, w, r, gameID, userID, logger); !authorized {
+		return
+	}
+
+	dbAsterisks, err := db.GetPlayerGameAsterisksByGameID(ctx, nil, gameID)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve asterisks")
+		return
+	}
+
+	apiAsterisks := make([]apiModels.AsteriskResponse, 0, len(dbAsterisks))
+	for i := range dbAsterisks {
+		apiAsterisk, convErr := modelConverters.DBAsteriskToAPIAsterisk(&dbAsterisks[i])
+		if convErr != nil {
+			logger.Error().Err(convErr).Msg("Failed to convert DB asterisk to API asterisk")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to process asterisks")
+			return
+		}
+		apiAsterisks = append(apiAsterisks, *apiAsterisk)
+	}
+
+	Respond(w, r, http.StatusOK, apiAsterisks, "Asterisks retrieved successfully")
+}
+
+// Handles removing an asterisk from a game
+// Path: /games/{game_id}/asterisks/{asterisk_id}
+// Method: DELETE
+func (ah *AsteriskHandler) HandleRemoveAsterisk(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		asteriskHandlerComponent,
+		"HandleRemoveAsterisk",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	asteriskID, ok := PathVar(w, r, "asterisk_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Str(l.AsteriskIDKey, asteriskID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	if _, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger); !authorized {
+		return
+	}
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for removing asterisk")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing asterisk removal")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+
+	// Step 1: Remove the asterisk
+	if opErr = db.DeletePlayerGameAsterisk(ctx, tx, gameID, asteriskID); opErr != nil {
+		if errors.Is(opErr, db.ErrPlayerGameAsteriskNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Asterisk not found")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to remove asterisk")
+		}
+		return
+	}
+
+	// Step 2: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for removing asterisk: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize removing asterisk")
+		return
+	}
+	committed = true
+
+	setGameETag(ctx, w, gameID, logger)
+	Respond(w, r, http.StatusOK, map[string]string{"asterisk_id": asteriskID}, "Asterisk removed successfully")
+}
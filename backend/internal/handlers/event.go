@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	cf "github.com/seankim658/skullking/internal/config"
+	"github.com/seankim658/skullking/internal/events"
+	l "github.com/seankim658/skullking/internal/logger"
+	apiModels "github.com/seankim658/skullking/internal/models/api"
+)
+
+const eventHandlerComponent = "handlers-event"
+
+// How often a comment line is sent on an idle stream so proxies don't close the connection
+const eventStreamKeepAliveInterval = 20 * time.Second
+
+type EventHandler struct {
+	Cfg *cf.Config
+}
+
+func NewEventHandler(cfg *cf.Config) *EventHandler {
+	return &EventHandler{Cfg: cfg}
+}
+
+// Handles streaming a game's changes to the client as Server-Sent Events. Each event names
+// what changed, clients refetch the game or round to get the new state.
+// Path: /games/{game_id}/events
+// Method: GET
+func (eh *EventHandler) HandleStreamGameEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		eventHandlerComponent,
+		"HandleStreamGameEvents",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	if _, authorized := CheckGameViewAccess(ctx, w, r, gameID, userID, logger); !authorized {
+		return
+	}
+
+	streamGameEvents(w, r, gameID, nil, logger)
+}
+
+// Streams a game's events to the client until it disconnects, the caller checks the client
+// can view the game. If `stillAllowed` is given, it is checked on every keep-alive and the
+// stream is closed once it reports the client lost access.
+func streamGameEvents(
+	w http.ResponseWriter,
+	r *http.Request,
+	gameID string,
+	stillAllowed func(ctx context.Context) bool,
+	logger zerolog.Logger,
+) {
+	ctx := r.Context()
+	rc := http.NewResponseController(w)
+	eventsCh, unsubscribe := events.Games.Subscribe(gameID)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprint(w, ": connected\n\n"); err != nil {
+		return
+	}
+	if err := rc.Flush(); err != nil {
+		logger.Error().Err(err).Msg("Response writer does not support streaming")
+		return
+	}
+	logger.Info().Msg("Client subscribed to game events")
+
+	keepAlive := time.NewTicker(eventStreamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info().Msg("Client unsubscribed from game events")
+			return
+		case <-keepAlive.C:
+			if stillAllowed != nil && !stillAllowed(ctx) {
+				logger.Info().Msg("Client lost access to game events, closing stream")
+				return
+			}
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case event := <-eventsCh:
+			payload, err := json.Marshal(event)
+			if err != nil {
+				logger.Error().Err(err).Str(l.EventTypeKey, event.Type).Msg("Failed to encode game event")
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, payload); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// Publishes a committed change of a game to the clients streaming its events. Must only be
+// called after the transaction making the change has been committed.
+func publishGameEvent(ctx context.Context, gameID, eventType, status string) {
+	events.Games.Publish(ctx, gameID, apiModels.GameEvent{
+		Type:       eventType,
+		GameID:     gameID,
+		Status:     status,
+		OccurredAt: time.Now(),
+	})
+}
+
+// Publishes a committed change of a round to the clients streaming its game's events. Must
+// only be called after the transaction making the change has been committed.
+func publishRoundEvent(ctx context.Context, gameID, eventType, roundID string, roundNumber int, status string) {
+	events.Games.Publish(ctx, gameID, apiModels.GameEvent{
+		Type:        eventType,
+		GameID:      gameID,
+		RoundID:     &roundID,
+		RoundNumber: &roundNumber,
+		Status:      status,
+		OccurredAt:  time.Now(),
+	})
+}
@@ -1,15 +1,23 @@
 package handlers
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
 	"runtime/debug"
 
+	"github.com/rs/zerolog"
+
 	cf "github.com/seankim658/skullking/internal/config"
 	db "github.com/seankim658/skullking/internal/database"
+	"github.com/seankim658/skullking/internal/games"
 	l "github.com/seankim658/skullking/internal/logger"
 	apiModels "github.com/seankim658/skullking/internal/models/api"
+	modelConverters "github.com/seankim658/skullking/internal/models/convert"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+	"github.com/seankim658/skullking/internal/scoring"
 )
 
 const gameHandlerComponent = "handlers-game"
@@ -44,6 +52,47 @@ func (hg *GameHandler) HandleCreateGame(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	tiebreakerRule := games.TiebreakerRuleSharedVictory
+	if req.TiebreakerRule != nil {
+		if !games.IsValidTiebreakerRule(*req.TiebreakerRule) {
+			ErrorResponse(w, r, http.StatusBadRequest, "Invalid tiebreaker rule")
+			return
+		}
+		tiebreakerRule = *req.TiebreakerRule
+	}
+
+	ruleset := scoring.Ruleset{Name: scoring.RulesetClassic}
+	if req.Ruleset != nil {
+		ruleset = scoring.Ruleset{
+			Name:       req.Ruleset.Name,
+			Kraken:     req.Ruleset.Kraken,
+			WhiteWhale: req.Ruleset.WhiteWhale,
+			Loot:       req.Ruleset.Loot,
+		}
+		if err := ruleset.Validate(); err != nil {
+			ErrorResponse(w, r, http.StatusBadRequest, "Invalid ruleset")
+			return
+		}
+	}
+
+	roundSchedule := games.RoundScheduleStandard
+	if req.RoundSchedule != nil {
+		if !games.IsValidRoundSchedule(*req.RoundSchedule) {
+			ErrorResponse(w, r, http.StatusBadRequest, "Invalid round schedule")
+			return
+		}
+		roundSchedule = *req.RoundSchedule
+	}
+
+	visibility := games.GameVisibilityParticipants
+	if req.Visibility != nil {
+		if !games.IsValidGameVisibility(*req.Visibility) {
+			ErrorResponse(w, r, http.StatusBadRequest, "Invalid visibility")
+			return
+		}
+		visibility = *req.Visibility
+	}
+
 	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for creating game")
 	if !txOk {
 		return
@@ -88,7 +137,19 @@ func (hg *GameHandler) HandleCreateGame(w http.ResponseWriter, r *http.Request)
 	initialStatus := "pending"
 	playerSeatingOrderRandomized := true
 
-	gameID, opErr = db.CreateGame(ctx, tx, finalSessionID, userID, userID, initialStatus, playerSeatingOrderRandomized)
+	gameID, opErr = db.CreateGame(
+		ctx,
+		tx,
+		finalSessionID,
+		userID,
+		userID,
+		initialStatus,
+		playerSeatingOrderRandomized,
+		tiebreakerRule,
+		ruleset,
+		roundSchedule,
+		visibility,
+	)
 	if opErr != nil {
 		logger.Error().Err(opErr).Msg("Failed to create game in database")
 		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to create gaem")
@@ -113,18 +174,62 @@ func (hg *GameHandler) HandleCreateGame(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	apiGameResponse := apiModels.GameResponse{
-		GameID:          createdGame.GameID,
-		Status:          createdGame.Status,
-		CreatedAt:       createdGame.CreatedAt,
-		CreatedByUserID: createdGame.CreatedByUserID,
-	}
-	if createdGame.SessionID.Valid {
-		apiGameResponse.SessionID = &createdGame.SessionID.String
+	apiGameResponse, convErr := modelConverters.DBGameToAPIGame(createdGame)
+	if convErr != nil {
+		logger.Error().Err(convErr).Msg("Failed to convert DB game to API game for response")
+		Respond(w, r, http.StatusCreated, map[string]string{"game_id": gameID}, "Game created successfully, but full details could not be retrieved")
+		return
 	}
+	w.Header().Set("ETag", versionETag(createdGame.Version))
 	Respond(w, r, http.StatusCreated, apiGameResponse, "Game created successfully")
 }
 
+// Handles retrieving a game with its players, every round played so far and the running
+// score of each player after every round
+// Path: /games/{game_id}
+// Method: GET
+func (gh *GameHandler) HandleGetGameDetail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameHandlerComponent,
+		"HandleGetGameDetail",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	dbGame, authorized := CheckGameViewAccess(ctx, w, r, gameID, userID, logger)
+	if !authorized {
+		return
+	}
+
+	response, err := buildGameDetail(ctx, nil, dbGame, userID, logger)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game details")
+		return
+	}
+	// Viewers let in by the game's visibility only see the accounts whose stats they could see
+	if !isGameParticipant(dbGame, response.Players, userID) {
+		if err := hidePrivateAccounts(ctx, response, userID); err != nil {
+			logger.Error().Err(err).Msg("Failed to check stats privacy of players")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game details")
+			return
+		}
+	}
+	w.Header().Set("ETag", versionETag(dbGame.Version))
+	Respond(w, r, http.StatusOK, response, "Game retrieved successfully")
+}
+
 // Handles adding a player (a registered user or guest) to an existing game
 // Path: /games/{game_id}/players
 // Method: POST
@@ -149,21 +254,20 @@ func (gh *GameHandler) HandleAddPlayerToGame(w http.ResponseWriter, r *http.Requ
 	}
 	logger = logger.With().Str(l.UserIDKey, authenticatedUserID).Logger()
 
-	_, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, authenticatedUserID, logger)
+	game, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, authenticatedUserID, logger)
 	if !authorized {
 		return
 	}
+	if game.Status != "pending" {
+		ErrorResponse(w, r, http.StatusConflict, "Players cannot be changed once the game has started")
+		return
+	}
 
 	var req apiModels.AddPlayerToGameRequest
 	if !ParseJSON(w, r, &req) {
 		return
 	}
-	if (req.UserID == nil || *req.UserID == "") && (req.GuestName == nil || *req.GuestName == "") {
-		ErrorResponse(w, r, http.StatusBadRequest, "Either user_id or guest_name must be provided")
-		return
-	}
-	if req.UserID != nil && *req.UserID != "" && req.GuestName != nil && *req.GuestName != "" {
-		ErrorResponse(w, r, http.StatusBadRequest, "Provide either user_id or guest_name, not both")
+	if !checkPlayerIdentity(w, r, req.UserID, req.GuestName) {
 		return
 	}
 	if req.SeatingOrder <= 0 {
@@ -177,15 +281,13 @@ func (gh *GameHandler) HandleAddPlayerToGame(w http.ResponseWriter, r *http.Requ
 	}
 
 	var opErr error
-	var gamePlayerID string
-	var finalGuestPlayerID *string
-	var playerDisplayName string
+	var player *apiModels.GamePlayerResponse
 
 	defer func() {
 		if p := recover(); p != nil {
 			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
 			_ = tx.Rollback()
-			if opErr == nil && gamePlayerID == "" {
+			if opErr == nil && player == nil {
 				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing player addition")
 			}
 		} else if opErr != nil {
@@ -194,7 +296,177 @@ func (gh *GameHandler) HandleAddPlayerToGame(w http.ResponseWriter, r *http.Requ
 		}
 	}()
 
-	// 1: Handle Guest Player (if applicable)
+	// 1: Lock the game and check it is still pending, it may have started since the check above
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+	current, opErr := db.GetGameByID(ctx, tx, gameID)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game")
+		return
+	}
+	if current.Status != "pending" {
+		opErr = errors.New("game has already started")
+		ErrorResponse(w, r, http.StatusConflict, "Players cannot be changed once the game has started")
+		return
+	}
+
+	// 2: Add the player, creating the guest player if needed
+	player, opErr = addPlayerToGame(ctx, tx, gameID, &req, false, logger)
+	if opErr != nil {
+		respondActionError(w, r, opErr, "Failed to add player to game")
+		return
+	}
+
+	// 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for adding player: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize adding player")
+		return
+	}
+	logger.Debug().Msg("Transaction committed successfully for adding player")
+	publishGameEvent(ctx, gameID, apiModels.GameEventPlayersUpdated, "")
+
+	// 4: Send response
+	setGameETag(ctx, w, gameID, logger)
+	Respond(w, r, http.StatusCreated, player, "Player added to game successfully")
+}
+
+// Handles removing a player who was added by mistake from a pending game. Players seated after
+// them move up one seat.
+// Path: /games/{game_id}/players/{game_player_id}
+// Method: DELETE
+func (gh *GameHandler) HandleRemovePlayerFromGame(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameHandlerComponent,
+		"HandleRemovePlayerFromGame",
+	)
+
+	gameID, gamePlayerID, ok := gameAndPlayerPathVars(w, r)
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Str(l.GamePlayerIDKey, gamePlayerID).Logger()
+
+	if _, ok := checkPendingGameScorekeeper(ctx, w, r, gameID, logger); !ok {
+		return
+	}
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for removing player")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing player removal")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+
+	// Step 1: Remove the player and close the gap in the seating order
+	if opErr = db.RemoveGamePlayer(ctx, tx, gameID, gamePlayerID); opErr != nil {
+		if errors.Is(opErr, db.ErrGamePlayerNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Player not found in this game")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to remove player")
+		}
+		return
+	}
+
+	// Step 2: Cancel the removed player's requests to keep score
+	if opErr = db.CancelScorekeeperRequestsOfDepartedPlayers(ctx, tx, gameID); opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to cancel pending scorekeeper requests")
+		return
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for removing player: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize player removal")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for removing player")
+	publishGameEvent(ctx, gameID, apiModels.GameEventPlayersUpdated, "")
+
+	respondWithGame(w, r, gameID, http.StatusOK, "Player removed successfully", logger)
+}
+
+// Handles replacing the registered user or guest in a pending game's seat, e.g. when the wrong
+// guest was picked. The seat keeps its place in the seating order.
+// Path: /games/{game_id}/players/{game_player_id}
+// Method: PUT
+func (gh *GameHandler) HandleReplacePlayer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameHandlerComponent,
+		"HandleReplacePlayer",
+	)
+
+	gameID, gamePlayerID, ok := gameAndPlayerPathVars(w, r)
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Str(l.GamePlayerIDKey, gamePlayerID).Logger()
+
+	if _, ok := checkPendingGameScorekeeper(ctx, w, r, gameID, logger); !ok {
+		return
+	}
+
+	var req apiModels.ReplacePlayerRequest
+	if !ParseJSON(w, r, &req) {
+		return
+	}
+	if !checkPlayerIdentity(w, r, req.UserID, req.GuestName) {
+		return
+	}
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for replacing player")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing player replacement")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+
+	// Step 1: Handle Guest Player (if applicable)
+	var guestPlayerID *string
 	if req.GuestName != nil && *req.GuestName != "" {
 		createdGuestID, err := db.FindOrCreateGuestPlayer(ctx, tx, *req.GuestName)
 		if err != nil {
@@ -203,63 +475,1187 @@ func (gh *GameHandler) HandleAddPlayerToGame(w http.ResponseWriter, r *http.Requ
 			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to process guest player")
 			return
 		}
-		finalGuestPlayerID = &createdGuestID
-		playerDisplayName = *req.GuestName
-		logger.Info().Str(l.GuestPlayerIDKey, *finalGuestPlayerID).Msg("Guest player processed")
+		guestPlayerID = &createdGuestID
 	}
 
-	// 2: Add Player to Game
-	gamePlayerID, opErr = db.AddPlayerToGame(ctx, tx, gameID, req.UserID, finalGuestPlayerID, req.SeatingOrder)
-	if opErr != nil {
-		logger.Error().Err(opErr).Msg("Failed to add player to game in database")
-		if errors.Is(opErr, db.ErrPlayerAlreadyInGame) {
+	// Step 2: Put the new player in the seat
+	if opErr = db.ReplaceGamePlayer(ctx, tx, gameID, gamePlayerID, req.UserID, guestPlayerID); opErr != nil {
+		if errors.Is(opErr, db.ErrGamePlayerNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Player not found in this game")
+		} else if errors.Is(opErr, db.ErrPlayerAlreadyInGame) {
 			ErrorResponse(w, r, http.StatusConflict, "This player is already in the game")
 		} else {
-			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to add player to game")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to replace player")
 		}
 		return
 	}
-	logger.Info().Str(l.GamePlayerIDKey, gamePlayerID).Msg("Player added to game in game_players table")
 
-	// 3: Determine Display Name (if a registered user)
-	if req.UserID != nil && *req.UserID != "" {
-		dbUser, userErr := db.GetUserByID(ctx, tx, *req.UserID)
-		if userErr != nil {
-			opErr = fmt.Errorf("failed to fetch user details for display name: %w", userErr)
-			logger.Error().Err(opErr).Str(l.UserIDKey, *req.UserID).Msg("Could not fetch user for display name")
-			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve player details")
-			return
+	// Step 3: Cancel the replaced player's requests to keep score
+	if opErr = db.CancelScorekeeperRequestsOfDepartedPlayers(ctx, tx, gameID); opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to cancel pending scorekeeper requests")
+		return
+	}
+
+	// Step 4: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for replacing player: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize player replacement")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for replacing player")
+	publishGameEvent(ctx, gameID, apiModels.GameEventPlayersUpdated, "")
+
+	respondWithGame(w, r, gameID, http.StatusOK, "Player replaced successfully", logger)
+}
+
+// Handles rewriting the seating order of every player in a pending game at once. The seats
+// must run from 1 to the number of players with no seat shared.
+// Path: /games/{game_id}/players/seating
+// Method: PUT
+func (gh *GameHandler) HandleReorderPlayers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameHandlerComponent,
+		"HandleReorderPlayers",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	if _, ok := checkPendingGameScorekeeper(ctx, w, r, gameID, logger); !ok {
+		return
+	}
+
+	var req apiModels.ReorderPlayersRequest
+	if !ParseJSON(w, r, &req) {
+		return
+	}
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for reordering players")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing seating order")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
 		}
-		if dbUser.DisplayName.Valid && dbUser.DisplayName.String != "" {
-			playerDisplayName = dbUser.DisplayName.String
+	}()
+
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+
+	// Step 1: Validate every player has exactly one seat and the seats are contiguous
+	players, opErr := db.GetGamePlayersByGameID(ctx, tx, gameID)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game players")
+		return
+	}
+	inGame := make(map[string]bool, len(players))
+	for _, p := range players {
+		inGame[p.GamePlayerID] = true
+	}
+	entries := make([]games.PlayerEntry, 0, len(req.Seats))
+	seats := make(map[string]int, len(req.Seats))
+	for _, seat := range req.Seats {
+		entries = append(entries, games.PlayerEntry{GamePlayerID: seat.GamePlayerID, Value: seat.SeatingOrder})
+		seats[seat.GamePlayerID] = seat.SeatingOrder
+	}
+	if entryErrs := games.ValidateSeating(entries, inGame); len(entryErrs) > 0 {
+		opErr = errors.New("seating order failed validation")
+		FieldErrorResponse(w, r, toFieldErrors(entryErrs))
+		return
+	}
+
+	// Step 2: Rewrite every seat in one statement
+	if opErr = db.UpdateGamePlayerSeats(ctx, tx, gameID, seats); opErr != nil {
+		if errors.Is(opErr, db.ErrGamePlayerNotFound) || errors.Is(opErr, db.ErrSeatTaken) {
+			ErrorResponse(w, r, http.StatusConflict, "The players in this game changed, please try again")
 		} else {
-			playerDisplayName = dbUser.Username
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to update seating order")
 		}
+		return
 	}
 
-	// 4: Commit Transaction
+	// Step 3: Commit Transaction
 	if err := tx.Commit(); err != nil {
-		opErr = fmt.Errorf("failed to commit transaction for adding player: %w", err)
+		opErr = fmt.Errorf("failed to commit transaction for reordering players: %w", err)
 		logger.Error().Err(opErr).Msg("Transaction commit failed")
-		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize adding player")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize seating order")
 		return
 	}
-	logger.Debug().Msg("Transaction committed successfully for adding player")
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for reordering players")
+	publishGameEvent(ctx, gameID, apiModels.GameEventPlayersUpdated, "")
+
+	respondWithGame(w, r, gameID, http.StatusOK, "Seating order updated successfully", logger)
+}
+
+// Handles starting a pending game, optionally randomizing the seating order and picking
+// the starting dealer
+// Path: /games/{game_id}/start
+// Method: POST
+func (gh *GameHandler) HandleStartGame(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameHandlerComponent,
+		"HandleStartGame",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
 
-	// 5: Send response
-	apiPlayerResponse := apiModels.GamePlayerResponse{
-		GamePlayerID: gamePlayerID,
-		GameID:       gameID,
-		DisplayName:  playerDisplayName,
-		SeatingOrder: req.SeatingOrder,
-		FinalScore:   0,
+	game, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger)
+	if !authorized {
+		return
 	}
-	if req.UserID != nil {
-		apiPlayerResponse.UserID = req.UserID
+	if game.Status != "pending" {
+		ErrorResponse(w, r, http.StatusConflict, "Only a pending game can be started")
+		return
 	}
-	if finalGuestPlayerID != nil {
-		apiPlayerResponse.GuestPlayerID = finalGuestPlayerID
+
+	var req apiModels.StartGameRequest
+	if !ParseJSON(w, r, &req) {
+		return
 	}
 
-	Respond(w, r, http.StatusCreated, apiPlayerResponse, "Player added to game successfully")
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for starting game")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing game start")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+
+	// Step 1: Check the player count
+	players, opErr := db.GetGamePlayersByGameID(ctx, tx, gameID)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game players")
+		return
+	}
+	if opErr = games.ValidatePlayerCount(len(players)); opErr != nil {
+		ErrorResponse(w, r, http.StatusBadRequest, fmt.Sprintf(
+			"A game needs between %d and %d players, this game has %d",
+			games.MinPlayers, games.MaxPlayers, len(players),
+		))
+		return
+	}
+
+	gamePlayerIDs := make([]string, 0, len(players))
+	for _, p := range players {
+		gamePlayerIDs = append(gamePlayerIDs, p.GamePlayerID)
+	}
+
+	// Step 2: Randomize the seating order if requested
+	randomizeSeating := game.PlayerSeatingOrderRandomized
+	if req.RandomizeSeating != nil {
+		randomizeSeating = *req.RandomizeSeating
+	}
+	if randomizeSeating {
+		gamePlayerIDs = games.ShuffleSeating(gamePlayerIDs)
+		seats := make(map[string]int, len(gamePlayerIDs))
+		for i, gamePlayerID := range gamePlayerIDs {
+			seats[gamePlayerID] = i + 1
+		}
+		if opErr = db.UpdateGamePlayerSeats(ctx, tx, gameID, seats); opErr != nil {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to randomize seating order")
+			return
+		}
+		logger.Info().Msg("Seating order randomized")
+	}
+
+	// Step 3: Choose the starting dealer
+	var startingDealerID string
+	if req.StartingDealerGamePlayerID != nil && *req.StartingDealerGamePlayerID != "" {
+		if _, found := findGamePlayer(players, *req.StartingDealerGamePlayerID); !found {
+			opErr = db.ErrGamePlayerNotFound
+			ErrorResponse(w, r, http.StatusBadRequest, "Starting dealer must be a player in this game")
+			return
+		}
+		startingDealerID = *req.StartingDealerGamePlayerID
+	} else {
+		startingDealerID = games.PickStartingDealer(gamePlayerIDs)
+	}
+	logger = logger.With().Str("starting_dealer_game_player_id", startingDealerID).Logger()
+
+	// Step 4: Activate the game
+	opErr = db.StartGame(ctx, tx, gameID, startingDealerID, randomizeSeating)
+	if opErr != nil {
+		if errors.Is(opErr, db.ErrGameStatusConflict) {
+			ErrorResponse(w, r, http.StatusConflict, "Only a pending game can be started")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to start game")
+		}
+		return
+	}
+
+	// Step 5: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for starting game: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize game start")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for starting game")
+	publishGameEvent(ctx, gameID, apiModels.GameEventStatusChanged, "active")
+
+	respondWithGame(w, r, gameID, http.StatusOK, "Game started successfully", logger)
+}
+
+// Handles marking a player as having left a game in progress, later rounds skip them when
+// rotating the deal and collecting bids
+// Path: /games/{game_id}/players/{game_player_id}/leave
+// Method: POST
+func (gh *GameHandler) HandleMarkPlayerLeft(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameHandlerComponent,
+		"HandleMarkPlayerLeft",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	gamePlayerID, ok := PathVar(w, r, "game_player_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Str(l.GamePlayerIDKey, gamePlayerID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	game, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger)
+	if !authorized {
+		return
+	}
+	if game.Status != "active" {
+		ErrorResponse(w, r, http.StatusConflict, "Players can only leave a game that is in progress")
+		return
+	}
+
+	players, err := db.GetGamePlayersByGameID(ctx, nil, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch players for game")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game players")
+		return
+	}
+	remainingPlayers := 0
+	for _, p := range players {
+		if !p.LeftAt.Valid && p.GamePlayerID != gamePlayerID {
+			remainingPlayers++
+		}
+	}
+	if remainingPlayers < games.MinPlayers {
+		ErrorResponse(w, r, http.StatusConflict, fmt.Sprintf("A game needs at least %d remaining players", games.MinPlayers))
+		return
+	}
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for marking player as left")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing player leaving")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+
+	if opErr = db.MarkGamePlayerLeft(ctx, tx, gameID, gamePlayerID); opErr != nil {
+		if errors.Is(opErr, db.ErrGamePlayerNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Player not found in this game or has already left")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to mark player as left")
+		}
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for marking player as left: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize player leaving")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for marking player as left")
+	publishGameEvent(ctx, gameID, apiModels.GameEventPlayersUpdated, "")
+
+	setGameETag(ctx, w, gameID, logger)
+	playerDetails, err := db.GetGamePlayerDetailsByGameID(ctx, nil, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch players for response")
+		Respond(w, r, http.StatusOK, nil, "Player marked as left, but players could not be retrieved")
+		return
+	}
+	Respond(w, r, http.StatusOK, toGamePlayerResponses(playerDetails), "Player marked as left successfully")
+}
+
+// Handles completing an active game once all its rounds are scored, recording each player's
+// final score and finishing position
+// Path: /games/{game_id}/complete
+// Method: POST
+func (gh *GameHandler) HandleCompleteGame(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameHandlerComponent,
+		"HandleCompleteGame",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	game, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger)
+	if !authorized {
+		return
+	}
+	if game.Status != "active" {
+		ErrorResponse(w, r, http.StatusConflict, "Only an active game can be completed")
+		return
+	}
+
+	// Step 1: Check every round has been played and scored
+	latestRound, err := db.GetLatestRoundByGameID(ctx, nil, gameID)
+	if err != nil {
+		if errors.Is(err, db.ErrRoundNotFound) {
+			ErrorResponse(w, r, http.StatusConflict, "This game has no rounds yet")
+		} else {
+			logger.Error().Err(err).Msg("Failed to fetch latest round for game")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game rounds")
+		}
+		return
+	}
+	if latestRound.Status != dbModels.RoundStatusCompleted {
+		ErrorResponse(w, r, http.StatusConflict, "The current round must be completed before completing the game")
+		return
+	}
+	roundCount, err := games.RoundCount(game.RoundSchedule)
+	if err != nil {
+		logger.Error().Err(err).Str("round_schedule", game.RoundSchedule).Msg("Game has an unknown round schedule")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to determine the game's round schedule")
+		return
+	}
+	if latestRound.RoundNumber < roundCount {
+		ErrorResponse(w, r, http.StatusConflict, fmt.Sprintf("All %d rounds must be played before completing the game", roundCount))
+		return
+	}
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for completing game")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing game completion")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+
+	// Step 2: Rank the players, a tie for first place must be played off if the game uses
+	// the tiebreaker round house rule
+	ranked, opErr := rankGameStandings(ctx, tx, gameID)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to total player scores")
+		return
+	}
+	if tied := games.TiedLeaders(ranked); tied != nil && game.TiebreakerRule == games.TiebreakerRuleTiebreakerRound {
+		opErr = errors.New("players are tied for first place")
+		Respond(
+			w,
+			r,
+			http.StatusConflict,
+			apiModels.TiebreakerRequiredResponse{TiedGamePlayerIDs: tied},
+			"Players are tied for first place, a tiebreaker round must be played",
+		)
+		return
+	}
+
+	// Step 3: Complete the game, this fails if another request completed it first
+	if opErr = db.CompleteGame(ctx, tx, gameID); opErr != nil {
+		if errors.Is(opErr, db.ErrGameStatusConflict) {
+			ErrorResponse(w, r, http.StatusConflict, "Only an active game can be completed")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to complete game")
+		}
+		return
+	}
+
+	// Step 4: Record each player's final score and finishing position
+	for _, standing := range ranked {
+		opErr = db.UpdateGamePlayerResult(ctx, tx, standing.GamePlayerID, standing.Score, standing.Position)
+		if opErr != nil {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to record player results")
+			return
+		}
+	}
+
+	// Step 5: Record activity on the parent session
+	if game.SessionID.Valid {
+		if opErr = db.TouchSession(ctx, tx, game.SessionID.String); opErr != nil {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to update game session")
+			return
+		}
+	}
+
+	// Step 6: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for completing game: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize game completion")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for completing game")
+	publishGameEvent(ctx, gameID, apiModels.GameEventStatusChanged, "completed")
+
+	respondWithGame(w, r, gameID, http.StatusOK, "Game completed successfully", logger)
+}
+
+// Handles abandoning a pending or active game, with an optional reason. Abandoned games are
+// left out of wins and stats.
+// Path: /games/{game_id}/abandon
+// Method: POST
+func (gh *GameHandler) HandleAbandonGame(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameHandlerComponent,
+		"HandleAbandonGame",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	game, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger)
+	if !authorized {
+		return
+	}
+	if !isGameUnfinished(game) {
+		ErrorResponse(w, r, http.StatusConflict, "Only a pending or active game can be abandoned")
+		return
+	}
+
+	// The request body is optional
+	var req apiModels.AbandonRequest
+	if r.ContentLength != 0 && !ParseJSON(w, r, &req) {
+		return
+	}
+	reason, reasonOk := OptionalReason(w, r, req.Reason)
+	if !reasonOk {
+		return
+	}
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for abandoning game")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing game abandonment")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+
+	// Step 1: Abandon the game, this fails if another request finished it first
+	if opErr = db.AbandonGame(ctx, tx, gameID, reason); opErr != nil {
+		if errors.Is(opErr, db.ErrGameStatusConflict) {
+			ErrorResponse(w, r, http.StatusConflict, "Only a pending or active game can be abandoned")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to abandon game")
+		}
+		return
+	}
+
+	// Step 2: Record activity on the parent session
+	if game.SessionID.Valid {
+		if opErr = db.TouchSession(ctx, tx, game.SessionID.String); opErr != nil {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to update game session")
+			return
+		}
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for abandoning game: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize game abandonment")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for abandoning game")
+	publishGameEvent(ctx, gameID, apiModels.GameEventStatusChanged, "abandoned")
+
+	respondWithGame(w, r, gameID, http.StatusOK, "Game abandoned successfully", logger)
+}
+
+// Handles undoing the most recent scoring action of a game. Submitted tricks are reverted
+// first, then submitted bids, then the bids players submitted themselves one at a time, latest
+// first, then the round's creation, so repeated calls step back through the game one action at
+// a time.
+// Path: /games/{game_id}/undo
+// Method: POST
+func (gh *GameHandler) HandleUndoLastAction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		gameHandlerComponent,
+		"HandleUndoLastAction",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	game, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger)
+	if !authorized {
+		return
+	}
+	if game.Status == "completed" {
+		ErrorResponse(w, r, http.StatusConflict, "A completed game cannot be undone")
+		return
+	}
+	if !isGameInProgress(game) {
+		ErrorResponse(w, r, http.StatusConflict, "This game is not in progress")
+		return
+	}
+
+	tx, txOk := StartScoringTx(ctx, w, r, logger, userID, "Failed to start transaction for undo")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing undo")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+
+	// Step 1: Find the round holding the most recent scoring action
+	var round *dbModels.Round
+	round, opErr = db.GetLatestRoundByGameID(ctx, tx, gameID)
+	if opErr != nil {
+		if errors.Is(opErr, db.ErrRoundNotFound) {
+			ErrorResponse(w, r, http.StatusConflict, "There is nothing to undo in this game")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game rounds")
+		}
+		return
+	}
+	logger = logger.With().Str(l.RoundIDKey, round.RoundID).Int(l.RoundNumberKey, round.RoundNumber).Logger()
+
+	// Step 2: Revert the action, the status transitions fail if another request changed the
+	// round first
+	response := apiModels.UndoResponse{RoundID: round.RoundID, RoundNumber: round.RoundNumber}
+	switch round.Status {
+	case dbModels.RoundStatusCompleted:
+		response.UndoneAction = apiModels.UndoActionTricksSubmitted
+		if opErr = db.UpdateRoundStatus(ctx, tx, round.RoundID, dbModels.RoundStatusCompleted, dbModels.RoundStatusPlaying); opErr == nil {
+			if opErr = db.ClearPlayerRoundResults(ctx, tx, round.RoundID); opErr == nil && round.KrakenDiscardedTricks != 0 {
+				opErr = db.SetRoundKrakenDiscardedTricks(ctx, tx, round.RoundID, 0)
+			}
+		}
+		status := dbModels.RoundStatusPlaying
+		response.RoundStatus = &status
+	case dbModels.RoundStatusPlaying:
+		response.UndoneAction = apiModels.UndoActionBidsSubmitted
+		if opErr = db.UpdateRoundStatus(ctx, tx, round.RoundID, dbModels.RoundStatusPlaying, dbModels.RoundStatusBidding); opErr == nil {
+			if opErr = db.DeletePlayerRoundScoresByRoundID(ctx, tx, round.RoundID); opErr == nil {
+				// Bonuses are only captured while the round is played
+				if opErr = db.DeleteRoundBonusEventsByRoundID(ctx, tx, round.RoundID); opErr == nil {
+					opErr = db.SetRoundBidsRevealed(ctx, tx, round.RoundID, false)
+				}
+			}
+		}
+		status := dbModels.RoundStatusBidding
+		response.RoundStatus = &status
+	default:
+		var latestBid *dbModels.PlayerRoundScore
+		latestBid, opErr = latestRoundBid(ctx, tx, round.RoundID)
+		if opErr != nil {
+			break
+		}
+		if latestBid == nil {
+			response.UndoneAction = apiModels.UndoActionRoundCreated
+			opErr = db.DeleteRound(ctx, tx, round.RoundID)
+			break
+		}
+		response.UndoneAction = apiModels.UndoActionBidSubmitted
+		response.GamePlayerID = &latestBid.GamePlayerID
+		status := dbModels.RoundStatusBidding
+		response.RoundStatus = &status
+		opErr = db.DeletePlayerRoundScore(ctx, tx, round.RoundID, latestBid.GamePlayerID)
+	}
+	if opErr != nil {
+		if errors.Is(opErr, db.ErrRoundStatusConflict) || errors.Is(opErr, db.ErrRoundNotFound) || errors.Is(opErr, db.ErrPlayerRoundScoreNotFound) {
+			ErrorResponse(w, r, http.StatusConflict, "The round changed while undoing, please try again")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to undo the last action")
+		}
+		return
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for undo: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize undo")
+		return
+	}
+	committed = true
+	logger.Info().Str("undone_action", response.UndoneAction).Msg("Last scoring action undone")
+
+	roundStatus := ""
+	if response.RoundStatus != nil {
+		roundStatus = *response.RoundStatus
+	}
+	publishRoundEvent(ctx, gameID, apiModels.GameEventRoundUpdated, round.RoundID, round.RoundNumber, roundStatus)
+
+	setGameETag(ctx, w, gameID, logger)
+	Respond(w, r, http.StatusOK, response, "Last action undone successfully")
+}
+
+// Checks exactly one of a registered user or a guest name was given for a player
+func checkPlayerIdentity(w http.ResponseWriter, r *http.Request, userID, guestName *string) bool {
+	if err := validatePlayerIdentity(userID, guestName); err != nil {
+		respondActionError(w, r, err, "Failed to validate player")
+		return false
+	}
+	return true
+}
+
+// Returns an `*actionError` unless exactly one of a registered user or a guest name was given
+func validatePlayerIdentity(userID, guestName *string) error {
+	hasUser := userID != nil && *userID != ""
+	hasGuest := guestName != nil && *guestName != ""
+	if !hasUser && !hasGuest {
+		return &actionError{status: http.StatusBadRequest, message: "Either user_id or guest_name must be provided"}
+	}
+	if hasUser && hasGuest {
+		return &actionError{status: http.StatusBadRequest, message: "Provide either user_id or guest_name, not both"}
+	}
+	return nil
+}
+
+// Adds a registered user or guest to a pending game at the requested seat, creating the guest
+// player if needed. Seats are filled in order so the seating stays contiguous. `joinedWithCode`
+// is set when players add themselves with the game's join code. Returns an `*actionError` if
+// the player or seat can't be added.
+func addPlayerToGame(
+	ctx context.Context,
+	tx *sql.Tx,
+	gameID string,
+	req *apiModels.AddPlayerToGameRequest,
+	joinedWithCode bool,
+	logger zerolog.Logger,
+) (*apiModels.GamePlayerResponse, error) {
+	if err := validatePlayerIdentity(req.UserID, req.GuestName); err != nil {
+		return nil, err
+	}
+	if req.SeatingOrder <= 0 {
+		return nil, &actionError{status: http.StatusBadRequest, message: "Seating order must be a positive integer"}
+	}
+
+	player := &apiModels.GamePlayerResponse{
+		GameID:         gameID,
+		SeatingOrder:   req.SeatingOrder,
+		JoinedWithCode: joinedWithCode,
+	}
+
+	// Handle Guest Player (if applicable)
+	if req.GuestName != nil && *req.GuestName != "" {
+		guestPlayerID, err := db.FindOrCreateGuestPlayer(ctx, tx, *req.GuestName)
+		if err != nil {
+			logger.Error().Err(err).Str(l.GuestPlayerNameKey, *req.GuestName).Msg("Error with guest player")
+			return nil, fmt.Errorf("failed to find or create guest player: %w", err)
+		}
+		player.GuestPlayerID = &guestPlayerID
+		player.DisplayName = *req.GuestName
+		logger.Info().Str(l.GuestPlayerIDKey, guestPlayerID).Msg("Guest player processed")
+	}
+
+	players, err := db.GetGamePlayersByGameID(ctx, tx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	if req.SeatingOrder > len(players)+1 {
+		return nil, &actionError{
+			status:  http.StatusBadRequest,
+			message: fmt.Sprintf("Seating order must be at most %d, the next open seat", len(players)+1),
+		}
+	}
+	player.GamePlayerID, err = db.AddPlayerToGame(ctx, tx, gameID, req.UserID, player.GuestPlayerID, req.SeatingOrder, joinedWithCode)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to add player to game in database")
+		if errors.Is(err, db.ErrPlayerAlreadyInGame) {
+			return nil, &actionError{status: http.StatusConflict, message: "This player is already in the game"}
+		}
+		if errors.Is(err, db.ErrSeatTaken) {
+			return nil, &actionError{status: http.StatusConflict, message: "This seat is already taken"}
+		}
+		return nil, err
+	}
+	logger.Info().Str(l.GamePlayerIDKey, player.GamePlayerID).Msg("Player added to game in game_players table")
+
+	// Determine Display Name (if a registered user)
+	if req.UserID != nil && *req.UserID != "" {
+		dbUser, err := db.GetUserByID(ctx, tx, *req.UserID)
+		if err != nil {
+			logger.Error().Err(err).Str(l.UserIDKey, *req.UserID).Msg("Could not fetch user for display name")
+			return nil, fmt.Errorf("failed to fetch user details for display name: %w", err)
+		}
+		player.UserID = req.UserID
+		if dbUser.DisplayName.Valid && dbUser.DisplayName.String != "" {
+			player.DisplayName = dbUser.DisplayName.String
+		} else {
+			player.DisplayName = dbUser.Username
+		}
+	}
+	return player, nil
+}
+
+// Reads the `game_id` and `game_player_id` path variables
+func gameAndPlayerPathVars(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return "", "", false
+	}
+	gamePlayerID, ok := PathVar(w, r, "game_player_id")
+	if !ok {
+		return "", "", false
+	}
+	return gameID, gamePlayerID, true
+}
+
+// Verifies the authenticated user is the scorekeeper of a game that has not started yet,
+// the roster can only change while the game is pending
+func checkPendingGameScorekeeper(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	gameID string,
+	logger zerolog.Logger,
+) (*dbModels.Game, bool) {
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return nil, false
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	game, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger)
+	if !authorized {
+		return nil, false
+	}
+	if game.Status != "pending" {
+		ErrorResponse(w, r, http.StatusConflict, "Players cannot be changed once the game has started")
+		return nil, false
+	}
+	return game, true
+}
+
+// Builds a game's detail with its players, every round played so far and the running score of
+// each player after every round. Hidden bids are left out, except the viewer's own.
+func buildGameDetail(
+	ctx context.Context,
+	tx *sql.Tx,
+	dbGame *dbModels.Game,
+	viewerUserID string,
+	logger zerolog.Logger,
+) (*apiModels.GameDetailResponse, error) {
+	playerDetails, err := db.GetGamePlayerDetailsByGameID(ctx, tx, dbGame.GameID)
+	if err != nil {
+		return nil, err
+	}
+	viewerPlayers := make([]dbModels.GamePlayer, 0, len(playerDetails))
+	for _, p := range playerDetails {
+		viewerPlayers = append(viewerPlayers, p.GamePlayer)
+	}
+	viewerID := viewerGamePlayerID(viewerPlayers, viewerUserID)
+	dbRounds, err := db.GetRoundsByGameID(ctx, tx, dbGame.GameID)
+	if err != nil {
+		return nil, err
+	}
+	dbScores, err := db.GetPlayerRoundScoresByGameID(ctx, tx, dbGame.GameID)
+	if err != nil {
+		return nil, err
+	}
+	dbBonusEvents, err := db.GetRoundBonusEventsByGameID(ctx, tx, dbGame.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiGame, convErr := modelConverters.DBGameToAPIGame(dbGame)
+	if convErr != nil {
+		logger.Error().Err(convErr).Msg("Failed to convert DB game to API game for response")
+		return nil, convErr
+	}
+	apiGame.Players = toGamePlayerResponses(playerDetails)
+
+	scoresByRound := make(map[string][]dbModels.PlayerRoundScore, len(dbRounds))
+	for _, score := range dbScores {
+		scoresByRound[score.RoundID] = append(scoresByRound[score.RoundID], score)
+	}
+	bonusEventsByRound := make(map[string][]dbModels.RoundBonusEvent, len(dbRounds))
+	for _, event := range dbBonusEvents {
+		bonusEventsByRound[event.RoundID] = append(bonusEventsByRound[event.RoundID], event)
+	}
+
+	// Only completed regular rounds count towards the running totals
+	apiRounds := make([]apiModels.RoundResponse, 0, len(dbRounds))
+	var scoredRounds []games.PlayerRoundScore
+	for i := range dbRounds {
+		round := &dbRounds[i]
+		apiRound, convErr := modelConverters.DBRoundToAPIRound(round, scoresByRound[round.RoundID], bonusEventsByRound[round.RoundID])
+		if convErr != nil {
+			logger.Error().Err(convErr).Str(l.RoundIDKey, round.RoundID).Msg("Failed to convert DB round to API round")
+			return nil, convErr
+		}
+		hideUnrevealedBids(apiRound, round, viewerID)
+		apiRounds = append(apiRounds, *apiRound)
+
+		if round.Status != dbModels.RoundStatusCompleted || round.IsTiebreakerRound {
+			continue
+		}
+		for _, score := range scoresByRound[round.RoundID] {
+			scoredRounds = append(scoredRounds, games.PlayerRoundScore{
+				GamePlayerID: score.GamePlayerID,
+				RoundNumber:  round.RoundNumber,
+				Score:        score.RoundScore,
+			})
+		}
+	}
+
+	gamePlayerIDs := make([]string, 0, len(playerDetails))
+	for _, p := range playerDetails {
+		gamePlayerIDs = append(gamePlayerIDs, p.GamePlayerID)
+	}
+	scoreboard := games.BuildScoreboard(gamePlayerIDs, scoredRounds)
+
+	apiScoreboard := make([]apiModels.ScoreboardResponse, 0, len(scoreboard))
+	for _, row := range scoreboard {
+		apiRow := apiModels.ScoreboardResponse{
+			GamePlayerID: row.GamePlayerID,
+			Rounds:       make([]apiModels.RunningScoreResponse, 0, len(row.Rounds)),
+			Total:        row.Total,
+		}
+		for _, running := range row.Rounds {
+			apiRow.Rounds = append(apiRow.Rounds, apiModels.RunningScoreResponse{
+				RoundNumber:  running.RoundNumber,
+				RoundScore:   running.RoundScore,
+				RunningTotal: running.RunningTotal,
+			})
+		}
+		apiScoreboard = append(apiScoreboard, apiRow)
+	}
+
+	return &apiModels.GameDetailResponse{
+		GameResponse: *apiGame,
+		Rounds:       apiRounds,
+		Scoreboard:   apiScoreboard,
+	}, nil
+}
+
+// Ranks the players of a game by their total score, separating players level on score by
+// their scores in any completed tiebreaker rounds
+func rankGameStandings(ctx context.Context, tx *sql.Tx, gameID string) ([]games.RankedStanding, error) {
+	totals, err := db.GetGamePlayerScoreTotals(ctx, tx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	tiebreakerScores, err := db.GetTiebreakerRoundScores(ctx, tx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]games.Standing, 0, len(totals))
+	for gamePlayerID, total := range totals {
+		standing := games.Standing{GamePlayerID: gamePlayerID, Score: total}
+		for _, roundScores := range tiebreakerScores {
+			if score, ok := roundScores[gamePlayerID]; ok {
+				standing.TiebreakScores = append(standing.TiebreakScores, score)
+			}
+		}
+		standings = append(standings, standing)
+	}
+	return games.RankStandings(standings), nil
+}
+
+// Fetches a game with its players and sends it as the API response
+func respondWithGame(
+	w http.ResponseWriter,
+	r *http.Request,
+	gameID string,
+	successStatus int,
+	successMessage string,
+	logger zerolog.Logger,
+) {
+	ctx := r.Context()
+	dbGame, err := db.GetGameByID(ctx, nil, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch game for response")
+		Respond(w, r, successStatus, map[string]string{"game_id": gameID}, successMessage+", but full details could not be retrieved")
+		return
+	}
+	playerDetails, err := db.GetGamePlayerDetailsByGameID(ctx, nil, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch game players for response")
+		Respond(w, r, successStatus, map[string]string{"game_id": gameID}, successMessage+", but full details could not be retrieved")
+		return
+	}
+
+	apiGameResponse, convErr := modelConverters.DBGameToAPIGame(dbGame)
+	if convErr != nil {
+		logger.Error().Err(convErr).Msg("Failed to convert DB game to API game for response")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to process game details")
+		return
+	}
+	apiGameResponse.Players = toGamePlayerResponses(playerDetails)
+
+	w.Header().Set("ETag", versionETag(dbGame.Version))
+	Respond(w, r, successStatus, apiGameResponse, successMessage)
+}
+
+// Removes the accounts of registered users whose stats the viewer can't see from a game shown to
+// someone outside it, following the same `stats_privacy` rule as profiles. Their names and
+// scores in the game are still shown. Spectators without an account, an empty viewerUserID, only
+// see accounts with public stats.
+func hidePrivateAccounts(ctx context.Context, game *apiModels.GameDetailResponse, viewerUserID string) error {
+	visible := make(map[string]bool)
+	isVisible := func(userID string) (bool, error) {
+		if v, ok := visible[userID]; ok {
+			return v, nil
+		}
+		user, err := db.GetUserByID(ctx, nil, userID)
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case user.StatsPrivacy == "public" || userID == viewerUserID:
+			visible[userID] = true
+		case user.StatsPrivacy == "friends_only" && viewerUserID != "":
+			status, err := db.GetFriendshipStatus(ctx, nil, viewerUserID, userID)
+			if err != nil {
+				return false, err
+			}
+			visible[userID] = status == dbModels.DBFriendshipStatusFriends
+		default:
+			visible[userID] = false
+		}
+		return visible[userID], nil
+	}
+
+	if game.CreatedByUserID != "" {
+		v, err := isVisible(game.CreatedByUserID)
+		if err != nil {
+			return err
+		}
+		if !v {
+			game.CreatedByUserID = ""
+		}
+	}
+	for i := range game.Players {
+		if game.Players[i].UserID == nil {
+			continue
+		}
+		v, err := isVisible(*game.Players[i].UserID)
+		if err != nil {
+			return err
+		}
+		if !v {
+			game.Players[i].UserID = nil
+		}
+	}
+	return nil
+}
+
+// Finds the bid most recently submitted in a round that is still bidding, nil if no one has bid
+func latestRoundBid(ctx context.Context, tx *sql.Tx, roundID string) (*dbModels.PlayerRoundScore, error) {
+	bids, err := db.GetPlayerRoundScoresByRoundID(ctx, tx, roundID)
+	if err != nil {
+		return nil, err
+	}
+	var latest *dbModels.PlayerRoundScore
+	for i := range bids {
+		if latest == nil || bids[i].CreatedAt.After(latest.CreatedAt) {
+			latest = &bids[i]
+		}
+	}
+	return latest, nil
+}
+
+// Reports whether a user is the creator, scorekeeper or a registered player of a game, who
+// always see every player's account
+func isGameParticipant(game *dbModels.Game, players []apiModels.GamePlayerResponse, userID string) bool {
+	if game.CreatedByUserID == userID || (game.CurrentScorekeeperUserID.Valid && game.CurrentScorekeeperUserID.String == userID) {
+		return true
+	}
+	for _, player := range players {
+		if player.UserID != nil && *player.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Converts game players with resolved display names to API responses
+func toGamePlayerResponses(players []db.GamePlayerDetail) []apiModels.GamePlayerResponse {
+	apiPlayers := make([]apiModels.GamePlayerResponse, 0, len(players))
+	for _, p := range players {
+		apiPlayer := apiModels.GamePlayerResponse{
+			GamePlayerID:   p.GamePlayerID,
+			GameID:         p.GameID,
+			DisplayName:    p.DisplayName,
+			SeatingOrder:   p.SeatingOrder,
+			FinalScore:     p.FinalScore,
+			JoinedWithCode: p.JoinedWithCode,
+		}
+		if p.UserID.Valid {
+			apiPlayer.UserID = &p.UserID.String
+		}
+		if p.GuestPlayerID.Valid {
+			apiPlayer.GuestPlayerID = &p.GuestPlayerID.String
+		}
+		if p.FinishingPosition.Valid {
+			position := int(p.FinishingPosition.Int32)
+			apiPlayer.FinishingPosition = &position
+		}
+		if p.LeftAt.Valid {
+			apiPlayer.LeftAt = &p.LeftAt.Time
+		}
+		apiPlayer.JoinedAt = &p.JoinedAt
+		apiPlayers = append(apiPlayers, apiPlayer)
+	}
+	return apiPlayers
 }
@@ -0,0 +1,532 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"time"
+
+	cf "github.com/seankim658/skullking/internal/config"
+	db "github.com/seankim658/skullking/internal/database"
+	"github.com/seankim658/skullking/internal/games"
+	l "github.com/seankim658/skullking/internal/logger"
+	apiModels "github.com/seankim658/skullking/internal/models/api"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const historyHandlerComponent = "handlers-history"
+
+type HistoryHandler struct {
+	Cfg *cf.Config
+}
+
+func NewHistoryHandler(cfg *cf.Config) *HistoryHandler {
+	return &HistoryHandler{Cfg: cfg}
+}
+
+// Handles retrieving the score change history of a game
+// Path: /games/{game_id}/history
+// Method: GET
+func (hh *HistoryHandler) HandleGetGameHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		historyHandlerComponent,
+		"HandleGetGameHistory",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	if _, authorized := CheckGameViewAccess(ctx, w, r, gameID, userID, logger); !authorized {
+		return
+	}
+
+	players, err := db.GetGamePlayersByGameID(ctx, nil, gameID)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game players")
+		return
+	}
+	rounds, err := db.GetRoundsByGameID(ctx, nil, gameID)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game rounds")
+		return
+	}
+	entries, err := db.GetScoreAuditLogByGameID(ctx, nil, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch score audit log")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game history")
+		return
+	}
+
+	hiddenBidRounds := make(map[string]bool)
+	for i := range rounds {
+		if bidsHidden(&rounds[i]) {
+			hiddenBidRounds[rounds[i].RoundID] = true
+		}
+	}
+	viewerID := viewerGamePlayerID(players, userID)
+
+	response := apiModels.GameHistoryResponse{
+		GameID:  gameID,
+		Entries: make([]apiModels.ScoreAuditEntryResponse, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		entryResponse := toScoreAuditEntryResponse(entry)
+		// Bids that are still hidden are listed without their values, except the viewer's own
+		if hiddenBidRounds[entry.RoundID] && entry.FieldName == "bid_amount" && entry.GamePlayerID.String != viewerID {
+			entryResponse.OldValue = nil
+			entryResponse.NewValue = nil
+			entryResponse.Restorable = false
+		}
+		response.Entries = append(response.Entries, entryResponse)
+	}
+
+	Respond(w, r, http.StatusOK, response, "Game history retrieved successfully")
+}
+
+// Handles retrieving the timeline of a game, every player added, round played, bonus and
+// correction in the order they happened with the standings after each one
+// Path: /games/{game_id}/timeline
+// Method: GET
+func (hh *HistoryHandler) HandleGetGameTimeline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		historyHandlerComponent,
+		"HandleGetGameTimeline",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	game, authorized := CheckGameViewAccess(ctx, w, r, gameID, userID, logger)
+	if !authorized {
+		return
+	}
+
+	players, err := db.GetGamePlayerDetailsByGameID(ctx, nil, gameID)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game players")
+		return
+	}
+	rounds, err := db.GetRoundsByGameID(ctx, nil, gameID)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game rounds")
+		return
+	}
+	bonusEvents, err := db.GetRoundBonusEventsByGameID(ctx, nil, gameID)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve bonus events")
+		return
+	}
+	entries, err := db.GetScoreAuditLogByGameID(ctx, nil, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch score audit log")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game history")
+		return
+	}
+
+	events, scoreChanges := timelineEvents(game, players, rounds, bonusEvents, entries)
+	timeline := games.BuildTimeline(events, scoreChanges)
+
+	response := apiModels.GameTimelineResponse{
+		GameID:  gameID,
+		Players: toGamePlayerResponses(players),
+		Events:  make([]apiModels.TimelineEventResponse, 0, len(timeline)),
+	}
+	for _, entry := range timeline {
+		response.Events = append(response.Events, toTimelineEventResponse(entry))
+	}
+
+	Respond(w, r, http.StatusOK, response, "Game timeline retrieved successfully")
+}
+
+// Handles restoring the previous value of a bid or tricks taken from the game's score history.
+// The restore is applied as a correction, so it is validated and recorded like any other.
+// Path: /games/{game_id}/history/{audit_id}/restore
+// Method: POST
+func (hh *HistoryHandler) HandleRestoreScoreAuditEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		historyHandlerComponent,
+		"HandleRestoreScoreAuditEntry",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	auditID, ok := PathVar(w, r, "audit_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Str(l.AuditIDKey, auditID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	game, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger)
+	if !authorized {
+		return
+	}
+	if !isGameInProgress(game) {
+		ErrorResponse(w, r, http.StatusConflict, "This game is no longer in progress")
+		return
+	}
+
+	// Step 1: Check the entry holds a value that can be restored
+	entry, err := db.GetScoreAuditEntry(ctx, nil, gameID, auditID)
+	if err != nil {
+		if errors.Is(err, db.ErrScoreAuditEntryNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "History entry not found")
+		} else {
+			logger.Error().Err(err).Msg("Failed to fetch score audit entry")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve history entry")
+		}
+		return
+	}
+	if !isRestorableField(entry) {
+		ErrorResponse(w, r, http.StatusConflict, "Only previous bids and tricks taken can be restored")
+		return
+	}
+	oldValue, err := strconv.Atoi(entry.OldValue.String)
+	if err != nil {
+		logger.Error().Err(err).Str(l.ValueKey, entry.OldValue.String).Msg("Score audit entry has a non-numeric old value")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to read history entry")
+		return
+	}
+
+	round, err := db.GetRoundByID(ctx, nil, entry.RoundID)
+	if err != nil {
+		if errors.Is(err, db.ErrRoundNotFound) {
+			ErrorResponse(w, r, http.StatusConflict, "The round of this history entry no longer exists")
+		} else {
+			logger.Error().Err(err).Msg("Failed to fetch round of score audit entry")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve round")
+		}
+		return
+	}
+	logger = logger.With().Str(l.RoundIDKey, round.RoundID).Int(l.RoundNumberKey, round.RoundNumber).Logger()
+
+	bidChanges := make(map[string]int)
+	trickChanges := make(map[string]int)
+	switch entry.FieldName {
+	case "bid_amount":
+		if round.Status == dbModels.RoundStatusBidding {
+			ErrorResponse(w, r, http.StatusConflict, "Bids can still be resubmitted while the round is bidding")
+			return
+		}
+		bidChanges[entry.GamePlayerID.String] = oldValue
+	case "tricks_taken":
+		if round.Status != dbModels.RoundStatusCompleted {
+			ErrorResponse(w, r, http.StatusConflict, "Tricks taken can only be restored once the round is completed")
+			return
+		}
+		trickChanges[entry.GamePlayerID.String] = oldValue
+	}
+
+	tx, txOk := StartScoringTx(ctx, w, r, logger, userID, "Failed to start transaction for restoring score")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing score restore")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+
+	// Step 2: Apply the old value as a correction
+	entryErrs, opErr := applyScoreCorrections(ctx, tx, game, round, bidChanges, trickChanges, round.KrakenDiscardedTricks)
+	if opErr != nil {
+		respondActionError(w, r, opErr, "Failed to restore score")
+		return
+	}
+	if len(entryErrs) > 0 {
+		opErr = errors.New("restored score failed validation")
+		FieldErrorResponse(w, r, toFieldErrors(entryErrs))
+		return
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for score restore: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize score restore")
+		return
+	}
+	committed = true
+	logger.Info().Msg("Score restored from history")
+	publishRoundEvent(ctx, gameID, apiModels.GameEventRoundUpdated, round.RoundID, round.RoundNumber, round.Status)
+
+	respondWithRound(w, r, round.RoundID, http.StatusOK, "Score restored successfully", logger)
+}
+
+// Reports whether an audit entry records a previous bid or tricks taken value
+func isRestorableField(entry *dbModels.ScoreAuditEntry) bool {
+	return entry.TableName == dbModels.AuditTablePlayerRoundScores &&
+		(entry.FieldName == "bid_amount" || entry.FieldName == "tricks_taken") &&
+		entry.GamePlayerID.Valid &&
+		entry.OldValue.Valid
+}
+
+func toScoreAuditEntryResponse(entry db.ScoreAuditEntryDetail) apiModels.ScoreAuditEntryResponse {
+	response := apiModels.ScoreAuditEntryResponse{
+		AuditID:              entry.AuditID,
+		RoundID:              entry.RoundID,
+		TableName:            entry.TableName,
+		Operation:            entry.Operation,
+		FieldName:            entry.FieldName,
+		ChangedByDisplayName: entry.ChangedByDisplayName,
+		ChangedAt:            entry.ChangedAt,
+		Restorable:           entry.RoundNumber.Valid && isRestorableField(&entry.ScoreAuditEntry),
+	}
+	if entry.RoundNumber.Valid {
+		roundNumber := int(entry.RoundNumber.Int32)
+		response.RoundNumber = &roundNumber
+	}
+	if entry.GamePlayerID.Valid {
+		response.GamePlayerID = &entry.GamePlayerID.String
+	}
+	if entry.OldValue.Valid {
+		response.OldValue = &entry.OldValue.String
+	}
+	if entry.NewValue.Valid {
+		response.NewValue = &entry.NewValue.String
+	}
+	if entry.ChangedByUserID.Valid {
+		response.ChangedByUserID = &entry.ChangedByUserID.String
+	}
+	return response
+}
+
+// Collects the events of a game's timeline from its timestamps, bonuses and score audit log,
+// along with every change to a regular round's scores for the running standings
+func timelineEvents(
+	game *dbModels.Game,
+	players []db.GamePlayerDetail,
+	rounds []dbModels.Round,
+	bonusEvents []dbModels.RoundBonusEvent,
+	entries []db.ScoreAuditEntryDetail,
+) ([]games.TimelineEvent, []games.RoundScoreChange) {
+	var events []games.TimelineEvent
+	for _, p := range players {
+		events = append(events, games.TimelineEvent{
+			Type:          games.TimelinePlayerAdded,
+			OccurredAt:    p.JoinedAt,
+			GamePlayerIDs: []string{p.GamePlayerID},
+		})
+	}
+	if game.StartedAt.Valid {
+		events = append(events, games.TimelineEvent{Type: games.TimelineGameStarted, OccurredAt: game.StartedAt.Time})
+	}
+
+	roundsByID := make(map[string]*dbModels.Round, len(rounds))
+	for i := range rounds {
+		roundsByID[rounds[i].RoundID] = &rounds[i]
+	}
+
+	// Round status changes become round events. The bids and results saved with them share
+	// their timestamp, so they are attached in a second pass.
+	type changeKey struct {
+		roundID   string
+		changedAt time.Time
+		field     string
+	}
+	grouped := make(map[changeKey]int)
+	for _, entry := range entries {
+		if entry.TableName != dbModels.AuditTableRounds || entry.FieldName != "status" {
+			continue
+		}
+		event := games.TimelineEvent{OccurredAt: entry.ChangedAt}
+		if entry.RoundNumber.Valid {
+			event.RoundNumber = int(entry.RoundNumber.Int32)
+		}
+		switch {
+		case entry.Operation == "INSERT":
+			event.Type = games.TimelineRoundStarted
+		case entry.NewValue.String == dbModels.RoundStatusPlaying && entry.OldValue.String == dbModels.RoundStatusBidding:
+			event.Type = games.TimelineBidsSubmitted
+			event.Field = "bid_amount"
+		case entry.NewValue.String == dbModels.RoundStatusCompleted:
+			event.Type = games.TimelineRoundCompleted
+			event.Field = "tricks_taken"
+		default:
+			event.Type = games.TimelineRoundUndone
+		}
+		if event.Field != "" {
+			grouped[changeKey{entry.RoundID, entry.ChangedAt, event.Field}] = len(events)
+		}
+		events = append(events, event)
+	}
+
+	var scoreChanges []games.RoundScoreChange
+	for _, entry := range entries {
+		if entry.TableName != dbModels.AuditTablePlayerRoundScores || !entry.GamePlayerID.Valid {
+			continue
+		}
+		if entry.FieldName == "round_score" {
+			round, ok := roundsByID[entry.RoundID]
+			if !ok || round.IsTiebreakerRound {
+				continue
+			}
+			score, _ := strconv.Atoi(entry.NewValue.String)
+			scoreChanges = append(scoreChanges, games.RoundScoreChange{
+				RoundID:      entry.RoundID,
+				GamePlayerID: entry.GamePlayerID.String,
+				Score:        score,
+				ChangedAt:    entry.ChangedAt,
+			})
+			continue
+		}
+		if (entry.FieldName != "bid_amount" && entry.FieldName != "tricks_taken") || !entry.NewValue.Valid {
+			continue
+		}
+		if round, ok := roundsByID[entry.RoundID]; ok && entry.FieldName == "bid_amount" && bidsHidden(round) {
+			continue
+		}
+		value, err := strconv.Atoi(entry.NewValue.String)
+		if err != nil {
+			continue
+		}
+
+		// Values changed outside a round's status change are corrections, or bids players
+		// placed themselves before the last one moved the round on
+		key := changeKey{entry.RoundID, entry.ChangedAt, entry.FieldName}
+		i, ok := grouped[key]
+		if !ok {
+			eventType := games.TimelineScoreCorrected
+			if !entry.OldValue.Valid {
+				if entry.FieldName != "bid_amount" {
+					continue
+				}
+				eventType = games.TimelineBidPlaced
+			}
+			event := games.TimelineEvent{
+				Type:       eventType,
+				OccurredAt: entry.ChangedAt,
+				Field:      entry.FieldName,
+			}
+			if entry.RoundNumber.Valid {
+				event.RoundNumber = int(entry.RoundNumber.Int32)
+			}
+			i = len(events)
+			grouped[key] = i
+			events = append(events, event)
+		}
+		events[i].Entries = append(events[i].Entries, games.PlayerEntry{GamePlayerID: entry.GamePlayerID.String, Value: value})
+		if events[i].Type == games.TimelineScoreCorrected || events[i].Type == games.TimelineBidPlaced {
+			events[i].GamePlayerIDs = append(events[i].GamePlayerIDs, entry.GamePlayerID.String)
+		}
+	}
+
+	for _, bonus := range bonusEvents {
+		event := games.TimelineEvent{
+			Type:          games.TimelineBonusRecorded,
+			OccurredAt:    bonus.CreatedAt,
+			GamePlayerIDs: []string{bonus.GamePlayerID},
+			BonusType:     bonus.BonusType,
+		}
+		if bonus.AllyGamePlayerID.Valid {
+			event.GamePlayerIDs = append(event.GamePlayerIDs, bonus.AllyGamePlayerID.String)
+		}
+		if round, ok := roundsByID[bonus.RoundID]; ok {
+			event.RoundNumber = round.RoundNumber
+		}
+		events = append(events, event)
+	}
+
+	if game.CompletedAt.Valid {
+		events = append(events, games.TimelineEvent{Type: games.TimelineGameCompleted, OccurredAt: game.CompletedAt.Time})
+	}
+	if game.AbandonedAt.Valid {
+		events = append(events, games.TimelineEvent{Type: games.TimelineGameAbandoned, OccurredAt: game.AbandonedAt.Time})
+	}
+
+	// List each event's values in seating order
+	seats := make(map[string]int, len(players))
+	for _, p := range players {
+		seats[p.GamePlayerID] = p.SeatingOrder
+	}
+	for i := range events {
+		sort.SliceStable(events[i].Entries, func(a, b int) bool {
+			return seats[events[i].Entries[a].GamePlayerID] < seats[events[i].Entries[b].GamePlayerID]
+		})
+	}
+	return events, scoreChanges
+}
+
+func toTimelineEventResponse(entry games.TimelineEntry) apiModels.TimelineEventResponse {
+	response := apiModels.TimelineEventResponse{
+		Type:          entry.Type,
+		OccurredAt:    entry.OccurredAt,
+		GamePlayerIDs: entry.GamePlayerIDs,
+		Standings:     make([]apiModels.TimelineStandingResponse, 0, len(entry.Standings)),
+	}
+	if entry.RoundNumber > 0 {
+		roundNumber := entry.RoundNumber
+		response.RoundNumber = &roundNumber
+	}
+	if entry.BonusType != "" {
+		bonusType := entry.BonusType
+		response.BonusType = &bonusType
+	}
+	if entry.Field != "" {
+		field := entry.Field
+		response.Field = &field
+	}
+	for _, value := range entry.Entries {
+		response.Values = append(response.Values, apiModels.TimelineEntryValueResponse{
+			GamePlayerID: value.GamePlayerID,
+			Value:        value.Value,
+		})
+	}
+	for _, standing := range entry.Standings {
+		response.Standings = append(response.Standings, apiModels.TimelineStandingResponse{
+			GamePlayerID: standing.GamePlayerID,
+			Score:        standing.Score,
+			Position:     standing.Position,
+		})
+	}
+	return response
+}
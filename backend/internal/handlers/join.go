@@ -0,0 +1,285 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	cf "github.com/seankim658/skullking/internal/config"
+	db "github.com/seankim658/skullking/internal/database"
+	"github.com/seankim658/skullking/internal/games"
+	l "github.com/seankim658/skullking/internal/logger"
+	apiModels "github.com/seankim658/skullking/internal/models/api"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const joinHandlerComponent = "handlers-join"
+
+// How long a join code can be used after it is created
+const joinCodeTTL = time.Hour
+
+// Attempts at generating a join code that no other game is using
+const joinCodeAttempts = 5
+
+type JoinHandler struct {
+	Cfg *cf.Config
+}
+
+func NewJoinHandler(cfg *cf.Config) *JoinHandler {
+	return &JoinHandler{Cfg: cfg}
+}
+
+// Handles the scorekeeper creating a join code for a pending game, replacing any code it
+// already has. Players enter the code, or scan the join URL as a QR code, to add themselves.
+// Path: /games/{game_id}/join-code
+// Method: POST
+func (jh *JoinHandler) HandleCreateJoinCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		joinHandlerComponent,
+		"HandleCreateJoinCode",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	game, ok := checkPendingGameScorekeeper(ctx, w, r, gameID, logger)
+	if !ok {
+		return
+	}
+
+	expiresAt := time.Now().Add(joinCodeTTL)
+	for attempt := 1; attempt <= joinCodeAttempts; attempt++ {
+		code, err := games.GenerateJoinCode()
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to generate join code")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to create join code")
+			return
+		}
+
+		joinCode, err := db.ReplaceGameJoinCode(ctx, nil, gameID, code, game.CurrentScorekeeperUserID.String, expiresAt)
+		if errors.Is(err, db.ErrJoinCodeTaken) {
+			logger.Debug().Int(l.CountKey, attempt).Msg("Generated join code is taken, generating another")
+			continue
+		}
+		if err != nil {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to create join code")
+			return
+		}
+
+		Respond(w, r, http.StatusCreated, jh.toJoinCodeResponse(joinCode), "Join code created successfully")
+		return
+	}
+
+	logger.Error().Int(l.CountKey, joinCodeAttempts).Msg("Could not generate a join code that isn't taken")
+	ErrorResponse(w, r, http.StatusInternalServerError, "Failed to create join code")
+}
+
+// Handles the scorekeeper retrieving the join code of a pending game
+// Path: /games/{game_id}/join-code
+// Method: GET
+func (jh *JoinHandler) HandleGetJoinCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		joinHandlerComponent,
+		"HandleGetJoinCode",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	if _, ok := checkPendingGameScorekeeper(ctx, w, r, gameID, logger); !ok {
+		return
+	}
+
+	joinCode, err := db.GetGameJoinCodeByGameID(ctx, nil, gameID)
+	if err != nil {
+		if errors.Is(err, db.ErrJoinCodeNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "This game has no join code")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve join code")
+		}
+		return
+	}
+	if !joinCode.ExpiresAt.After(time.Now()) {
+		ErrorResponse(w, r, http.StatusNotFound, "The join code of this game has expired")
+		return
+	}
+
+	Respond(w, r, http.StatusOK, jh.toJoinCodeResponse(joinCode), "Join code retrieved successfully")
+}
+
+// Handles the scorekeeper removing the join code of a pending game so no one else can join with it
+// Path: /games/{game_id}/join-code
+// Method: DELETE
+func (jh *JoinHandler) HandleDeleteJoinCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		joinHandlerComponent,
+		"HandleDeleteJoinCode",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	if _, ok := checkPendingGameScorekeeper(ctx, w, r, gameID, logger); !ok {
+		return
+	}
+
+	if err := db.DeleteGameJoinCode(ctx, nil, gameID); err != nil {
+		if errors.Is(err, db.ErrJoinCodeNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "This game has no join code")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to remove join code")
+		}
+		return
+	}
+
+	Respond(w, r, http.StatusOK, map[string]string{"game_id": gameID}, "Join code removed successfully")
+}
+
+// Handles the authenticated user joining a pending game with its join code, taking the next
+// open seat. The scorekeeper sees who joined in the game's players and can remove anyone who
+// joined by mistake.
+// Path: /games/join/{code}
+// Method: POST
+func (jh *JoinHandler) HandleJoinGame(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		joinHandlerComponent,
+		"HandleJoinGame",
+	)
+
+	rawCode, ok := PathVar(w, r, "code")
+	if !ok {
+		return
+	}
+	code := games.NormalizeJoinCode(rawCode)
+	logger = logger.With().Str(l.JoinCodeKey, code).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	joinCode, err := db.GetGameJoinCodeByCode(ctx, nil, code)
+	if err != nil {
+		if errors.Is(err, db.ErrJoinCodeNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Join code not found")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve join code")
+		}
+		return
+	}
+	if !joinCode.ExpiresAt.After(time.Now()) {
+		ErrorResponse(w, r, http.StatusGone, "This join code has expired")
+		return
+	}
+	gameID := joinCode.GameID
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for joining game")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var player *apiModels.GamePlayerResponse
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && player == nil {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing join")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Step 1: Lock the game so players joining at the same time take different seats, and check
+	// it hasn't started
+	if _, opErr = db.LockGameVersion(ctx, tx, gameID); opErr != nil {
+		if errors.Is(opErr, db.ErrGameNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Game not found")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to join game")
+		}
+		return
+	}
+	game, opErr := db.GetGameByID(ctx, tx, gameID)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game")
+		return
+	}
+	if game.Status != "pending" {
+		opErr = errors.New("game has already started")
+		ErrorResponse(w, r, http.StatusConflict, "This game has already started")
+		return
+	}
+
+	// Step 2: Take the next open seat
+	players, opErr := db.GetGamePlayersByGameID(ctx, tx, gameID)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game players")
+		return
+	}
+	if viewerGamePlayerID(players, userID) != "" {
+		opErr = db.ErrPlayerAlreadyInGame
+		ErrorResponse(w, r, http.StatusConflict, "You are already in this game")
+		return
+	}
+	if len(players) >= games.MaxPlayers {
+		opErr = games.ErrTooManyPlayers
+		ErrorResponse(w, r, http.StatusConflict, fmt.Sprintf("This game already has the most players allowed, %d", games.MaxPlayers))
+		return
+	}
+	req := apiModels.AddPlayerToGameRequest{UserID: &userID, SeatingOrder: len(players) + 1}
+	player, opErr = addPlayerToGame(ctx, tx, gameID, &req, true, logger)
+	if opErr != nil {
+		respondActionError(w, r, opErr, "Failed to join game")
+		return
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for joining game: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize joining game")
+		return
+	}
+	logger.Info().Str(l.GamePlayerIDKey, player.GamePlayerID).Msg("User joined game with join code")
+	publishGameEvent(ctx, gameID, apiModels.GameEventPlayersUpdated, "")
+
+	setGameETag(ctx, w, gameID, logger)
+	Respond(w, r, http.StatusCreated, player, "Joined game successfully")
+}
+
+// Converts a join code to its API response with the URL players can open or scan to join
+func (jh *JoinHandler) toJoinCodeResponse(joinCode *dbModels.GameJoinCode) apiModels.JoinCodeResponse {
+	return apiModels.JoinCodeResponse{
+		GameID:    joinCode.GameID,
+		Code:      joinCode.Code,
+		JoinURL:   strings.TrimRight(jh.Cfg.FrontendBaseURL, "/") + "/join/" + joinCode.Code,
+		ExpiresAt: joinCode.ExpiresAt,
+	}
+}
@@ -0,0 +1,1513 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/rs/zerolog"
+
+	a "github.com/seankim658/skullking/internal/auth"
+	cf "github.com/seankim658/skullking/internal/config"
+	db "github.com/seankim658/skullking/internal/database"
+	"github.com/seankim658/skullking/internal/games"
+	l "github.com/seankim658/skullking/internal/logger"
+	apiModels "github.com/seankim658/skullking/internal/models/api"
+	modelConverters "github.com/seankim658/skullking/internal/models/convert"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+	"github.com/seankim658/skullking/internal/scoring"
+)
+
+const roundHandlerComponent = "handlers-round"
+
+type RoundHandler struct {
+	Cfg *cf.Config
+}
+
+func NewRoundHandler(cfg *cf.Config) *RoundHandler {
+	return &RoundHandler{Cfg: cfg}
+}
+
+// Handles starting the next round of a game
+// Path: /games/{game_id}/rounds
+// Method: POST
+func (rh *RoundHandler) HandleCreateRound(w http.ResponseWriter, r *http.Request) {
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(r.Context()),
+		roundHandlerComponent,
+		"HandleCreateRound",
+	)
+	rh.createRound(w, r, logger, false)
+}
+
+// Handles starting a tiebreaker round, played only by the players tied for first place once
+// every regular round is complete. Its scores decide the winner but don't count towards the
+// final scores.
+// Path: /games/{game_id}/rounds/tiebreaker
+// Method: POST
+func (rh *RoundHandler) HandleCreateTiebreakerRound(w http.ResponseWriter, r *http.Request) {
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(r.Context()),
+		roundHandlerComponent,
+		"HandleCreateTiebreakerRound",
+	)
+	rh.createRound(w, r, logger, true)
+}
+
+// Starts the next regular or tiebreaker round of the game from the `game_id` path variable
+func (rh *RoundHandler) createRound(w http.ResponseWriter, r *http.Request, logger zerolog.Logger, isTiebreaker bool) {
+	ctx := r.Context()
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	if _, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger); !authorized {
+		return
+	}
+
+	tx, txOk := StartScoringTx(ctx, w, r, logger, userID, "Failed to start transaction for creating round")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var roundID string
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && roundID == "" {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing round creation")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Step 1: Lock the game and check it hasn't changed since the client fetched it
+	if opErr = checkGameVersion(ctx, w, r, tx, gameID, logger); opErr != nil {
+		return
+	}
+	game, opErr := db.GetGameByID(ctx, tx, gameID)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game")
+		return
+	}
+
+	// Step 2: Work out the number, cards dealt and dealer of the next round
+	next, opErr := planNextRound(ctx, tx, game, isTiebreaker, logger)
+	if opErr != nil {
+		respondActionError(w, r, opErr, "Failed to determine the next round")
+		return
+	}
+	nextRoundNumber, cardsDealt, dealerID := next.RoundNumber, next.CardsDealt, next.DealerID
+	logger = logger.With().
+		Int(l.RoundNumberKey, nextRoundNumber).
+		Int(l.CardsDealtKey, cardsDealt).
+		Str("dealer_game_player_id", dealerID).
+		Logger()
+
+	// Step 3: Create the round
+	roundID, opErr = db.CreateRound(ctx, tx, gameID, nextRoundNumber, cardsDealt, dealerID, isTiebreaker)
+	if opErr != nil {
+		if errors.Is(opErr, db.ErrRoundAlreadyExists) {
+			ErrorResponse(w, r, http.StatusConflict, "This round has already been started")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to create round")
+		}
+		return
+	}
+
+	// Step 4: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for round creation: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize round creation")
+		return
+	}
+	logger.Debug().Msg("Transaction committed successfully for round creation")
+	publishRoundEvent(ctx, gameID, apiModels.GameEventRoundCreated, roundID, nextRoundNumber, dbModels.RoundStatusBidding)
+
+	respondWithRound(w, r, roundID, http.StatusCreated, "Round created successfully", logger)
+}
+
+// Handles submitting the bids of every player for a round, moving it from bidding to playing.
+// Responds with 409 if players have submitted hidden bids of their own that haven't been
+// revealed yet, so they aren't overwritten unseen.
+// Path: /rounds/{round_id}/bids
+// Method: PUT
+func (rh *RoundHandler) HandleSubmitBids(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundHandlerComponent,
+		"HandleSubmitBids",
+	)
+
+	round, game, ok := getRoundAndCheckScorekeeper(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+	if round.Status != dbModels.RoundStatusBidding {
+		ErrorResponse(w, r, http.StatusConflict, "This round is no longer accepting bids")
+		return
+	}
+
+	var req apiModels.SubmitBidsRequest
+	if !ParseJSON(w, r, &req) {
+		return
+	}
+
+	// Step 1: Validate there is exactly one bid within the cards dealt for every player taking
+	// part in the round
+	if err := validateRoundBids(ctx, nil, round, req.Bids, logger); err != nil {
+		respondActionError(w, r, err, "Failed to validate bids")
+		return
+	}
+
+	tx, txOk := StartScoringTx(ctx, w, r, logger, game.CurrentScorekeeperUserID.String, "Failed to start transaction for submitting bids")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing bid submission")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkRoundVersion(ctx, w, r, tx, round, logger); opErr != nil {
+		return
+	}
+
+	// Step 2: Save the bids and move the round to playing
+	if opErr = saveRoundBids(ctx, tx, round, req.Bids); opErr != nil {
+		respondActionError(w, r, opErr, "Failed to save bids")
+		return
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for bid submission: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize bid submission")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for bid submission")
+	publishRoundEvent(ctx, round.GameID, apiModels.GameEventBidsSubmitted, round.RoundID, round.RoundNumber, dbModels.RoundStatusPlaying)
+
+	respondWithRound(w, r, round.RoundID, http.StatusOK, "Bids submitted successfully", logger)
+}
+
+// Handles a registered player submitting their own bid for a round from their device. Other
+// players' bids stay hidden from everyone, the scorekeeper included, until every player in the
+// round has bid, which moves the round to playing, or the scorekeeper reveals them. Each player
+// bids once and only while the bids are hidden, after a reveal only the scorekeeper can enter
+// or change bids. Players bid at the same time, so no `If-Match` header is needed.
+// Path: /rounds/{round_id}/bids/me
+// Method: PUT
+func (rh *RoundHandler) HandleSubmitOwnBid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundHandlerComponent,
+		"HandleSubmitOwnBid",
+	)
+
+	roundID, ok := PathVar(w, r, "round_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.RoundIDKey, roundID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	round, err := db.GetRoundByID(ctx, nil, roundID)
+	if err != nil {
+		if errors.Is(err, db.ErrRoundNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Round not found")
+		} else {
+			logger.Error().Err(err).Msg("Failed to fetch round")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve round")
+		}
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, round.GameID).Int(l.RoundNumberKey, round.RoundNumber).Logger()
+
+	game, err := db.GetGameByID(ctx, nil, round.GameID)
+	if err != nil {
+		if errors.Is(err, db.ErrGameNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Game not found")
+		} else {
+			logger.Error().Err(err).Msg("Failed to fetch game")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game")
+		}
+		return
+	}
+	if !isGameInProgress(game) {
+		ErrorResponse(w, r, http.StatusConflict, "This game is no longer in progress")
+		return
+	}
+	if round.Status != dbModels.RoundStatusBidding {
+		ErrorResponse(w, r, http.StatusConflict, "This round is no longer accepting bids")
+		return
+	}
+	if !bidsHidden(round) {
+		ErrorResponse(w, r, http.StatusConflict, "The bids of this round have been revealed, ask the scorekeeper to enter your bid")
+		return
+	}
+
+	var req apiModels.SubmitOwnBidRequest
+	if !ParseJSON(w, r, &req) {
+		return
+	}
+
+	// Step 1: Validate the user is a player taking part in the round and the bid is within the
+	// cards dealt
+	players, err := db.GetGamePlayersByGameID(ctx, nil, round.GameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch game players")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game players")
+		return
+	}
+	participants, err := roundParticipants(ctx, nil, round, players)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to determine round participants")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to determine the players in this round")
+		return
+	}
+	gamePlayerID := viewerGamePlayerID(players, userID)
+	if !participants[gamePlayerID] {
+		ErrorResponse(w, r, http.StatusForbidden, "Only players taking part in this round can submit their own bid")
+		return
+	}
+	logger = logger.With().Str(l.GamePlayerIDKey, gamePlayerID).Logger()
+
+	bid := []games.PlayerEntry{{GamePlayerID: gamePlayerID, Value: req.BidAmount}}
+	if entryErrs := games.ValidateBids(bid, map[string]bool{gamePlayerID: true}, round.CardsDealt); len(entryErrs) > 0 {
+		FieldErrorResponse(w, r, toFieldErrors(entryErrs))
+		return
+	}
+
+	tx, txOk := StartScoringTx(ctx, w, r, logger, userID, "Failed to start transaction for submitting bid")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing bid")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Step 2: Lock the round, in the same order as other round writes, and check it is still
+	// taking bids
+	if _, opErr = db.LockGameVersion(ctx, tx, round.GameID); opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to lock round")
+		return
+	}
+	if _, opErr = db.LockRoundVersion(ctx, tx, round.RoundID); opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to lock round")
+		return
+	}
+	current, opErr := db.GetRoundByID(ctx, tx, round.RoundID)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve round")
+		return
+	}
+	if current.Status != dbModels.RoundStatusBidding {
+		opErr = db.ErrRoundStatusConflict
+		ErrorResponse(w, r, http.StatusConflict, "This round is no longer accepting bids")
+		return
+	}
+	// Once the bids are revealed, players could bid knowing everyone else's bid, so only the
+	// scorekeeper can enter or change bids
+	if current.BidsRevealedAt.Valid {
+		opErr = db.ErrRoundStatusConflict
+		ErrorResponse(w, r, http.StatusConflict, "The bids of this round have been revealed, ask the scorekeeper to enter your bid")
+		return
+	}
+
+	// Step 3: Save the bid, each player bids once
+	scores, opErr := db.GetPlayerRoundScoresByRoundID(ctx, tx, round.RoundID)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve bids")
+		return
+	}
+	hasBid := make(map[string]bool, len(scores)+1)
+	for _, score := range scores {
+		hasBid[score.GamePlayerID] = true
+	}
+	if hasBid[gamePlayerID] {
+		opErr = db.ErrRoundStatusConflict
+		ErrorResponse(w, r, http.StatusConflict, "You have already bid in this round")
+		return
+	}
+	if opErr = db.UpsertPlayerRoundBid(ctx, tx, round.RoundID, gamePlayerID, req.BidAmount); opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to save bid")
+		return
+	}
+	hasBid[gamePlayerID] = true
+
+	// Step 4: Move the round to playing once every player in it has bid
+	allBid := true
+	for participantID := range participants {
+		if !hasBid[participantID] {
+			allBid = false
+			break
+		}
+	}
+	if allBid {
+		if opErr = db.UpdateRoundStatus(ctx, tx, round.RoundID, dbModels.RoundStatusBidding, dbModels.RoundStatusPlaying); opErr != nil {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to start playing the round")
+			return
+		}
+	}
+
+	// Step 5: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for own bid: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize bid")
+		return
+	}
+	committed = true
+	logger.Debug().Bool("all_bid", allBid).Msg("Transaction committed successfully for own bid")
+	if allBid {
+		publishRoundEvent(ctx, round.GameID, apiModels.GameEventBidsSubmitted, round.RoundID, round.RoundNumber, dbModels.RoundStatusPlaying)
+	} else {
+		publishRoundEvent(ctx, round.GameID, apiModels.GameEventBidPlaced, round.RoundID, round.RoundNumber, dbModels.RoundStatusBidding)
+	}
+
+	respondWithRound(w, r, round.RoundID, http.StatusOK, "Bid submitted successfully", logger)
+}
+
+// Handles the scorekeeper revealing the bids players have submitted themselves before everyone
+// has bid, e.g. when a player has no device. The scorekeeper can then enter every bid as usual.
+// Path: /rounds/{round_id}/bids/reveal
+// Method: POST
+func (rh *RoundHandler) HandleRevealBids(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundHandlerComponent,
+		"HandleRevealBids",
+	)
+
+	round, game, ok := getRoundAndCheckScorekeeper(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+	if round.Status != dbModels.RoundStatusBidding {
+		ErrorResponse(w, r, http.StatusConflict, "Bids can only be revealed while the round is bidding")
+		return
+	}
+	if !bidsHidden(round) {
+		ErrorResponse(w, r, http.StatusConflict, "The bids of this round have already been revealed")
+		return
+	}
+
+	tx, txOk := StartScoringTx(ctx, w, r, logger, game.CurrentScorekeeperUserID.String, "Failed to start transaction for revealing bids")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error revealing bids")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkRoundVersion(ctx, w, r, tx, round, logger); opErr != nil {
+		return
+	}
+
+	// Step 1: Reveal the bids
+	if opErr = db.SetRoundBidsRevealed(ctx, tx, round.RoundID, true); opErr != nil {
+		if errors.Is(opErr, db.ErrRoundNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Round not found")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to reveal bids")
+		}
+		return
+	}
+
+	// Step 2: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for revealing bids: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize revealing bids")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for revealing bids")
+	publishRoundEvent(ctx, round.GameID, apiModels.GameEventBidsRevealed, round.RoundID, round.RoundNumber, dbModels.RoundStatusBidding)
+
+	respondWithRound(w, r, round.RoundID, http.StatusOK, "Bids revealed successfully", logger)
+}
+
+// Handles submitting the tricks taken by every player for a round, scoring and completing it
+// Path: /rounds/{round_id}/tricks
+// Method: PUT
+func (rh *RoundHandler) HandleSubmitTricks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundHandlerComponent,
+		"HandleSubmitTricks",
+	)
+
+	round, game, ok := getRoundAndCheckScorekeeper(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+	if round.Status != dbModels.RoundStatusPlaying {
+		ErrorResponse(w, r, http.StatusConflict, "Tricks can only be submitted for a round that is being played")
+		return
+	}
+
+	var req apiModels.SubmitTricksRequest
+	if !ParseJSON(w, r, &req) {
+		return
+	}
+
+	// Step 1: Validate there is exactly one result for every player who bid and that the
+	// results add up to the tricks played
+	if err := validateRoundTricks(ctx, nil, game, round, &req, logger); err != nil {
+		respondActionError(w, r, err, "Failed to validate tricks")
+		return
+	}
+
+	tx, txOk := StartScoringTx(ctx, w, r, logger, game.CurrentScorekeeperUserID.String, "Failed to start transaction for submitting tricks")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing trick submission")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkRoundVersion(ctx, w, r, tx, round, logger); opErr != nil {
+		return
+	}
+
+	// Step 2: Score each player's result, including the bonuses recorded during the round, and
+	// complete the round
+	if opErr = saveRoundTricks(ctx, tx, game, round, &req); opErr != nil {
+		respondActionError(w, r, opErr, "Failed to score round")
+		return
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for trick submission: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize trick submission")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for trick submission")
+	publishRoundEvent(ctx, round.GameID, apiModels.GameEventTricksSubmitted, round.RoundID, round.RoundNumber, dbModels.RoundStatusCompleted)
+
+	respondWithRound(w, r, round.RoundID, http.StatusOK, "Tricks submitted successfully", logger)
+}
+
+// Handles correcting the bids or tricks taken of a round after they were submitted. Every
+// change is recorded in the game's score history. Completed rounds are revalidated and
+// rescored.
+// Path: /rounds/{round_id}/scores
+// Method: PATCH
+func (rh *RoundHandler) HandleCorrectScores(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundHandlerComponent,
+		"HandleCorrectScores",
+	)
+
+	round, game, ok := getRoundAndCheckScorekeeper(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+	if round.Status == dbModels.RoundStatusBidding {
+		ErrorResponse(w, r, http.StatusConflict, "Bids can still be resubmitted while the round is bidding")
+		return
+	}
+
+	var req apiModels.CorrectScoresRequest
+	if !ParseJSON(w, r, &req) {
+		return
+	}
+	if len(req.Corrections) == 0 && req.KrakenDiscardedTricks == nil {
+		ErrorResponse(w, r, http.StatusBadRequest, "At least one correction is required")
+		return
+	}
+
+	// Step 1: Collect the corrected values
+	bidChanges := make(map[string]int)
+	trickChanges := make(map[string]int)
+	for _, correction := range req.Corrections {
+		if correction.BidAmount != nil {
+			bidChanges[correction.GamePlayerID] = *correction.BidAmount
+		}
+		if correction.TricksTaken != nil {
+			trickChanges[correction.GamePlayerID] = *correction.TricksTaken
+		}
+	}
+	krakenDiscardedTricks := round.KrakenDiscardedTricks
+	if req.KrakenDiscardedTricks != nil {
+		krakenDiscardedTricks = *req.KrakenDiscardedTricks
+	}
+	if round.Status != dbModels.RoundStatusCompleted && (len(trickChanges) > 0 || req.KrakenDiscardedTricks != nil) {
+		ErrorResponse(w, r, http.StatusConflict, "Tricks taken can only be corrected once the round is completed")
+		return
+	}
+
+	tx, txOk := StartScoringTx(ctx, w, r, logger, game.CurrentScorekeeperUserID.String, "Failed to start transaction for correcting scores")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing score correction")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkRoundVersion(ctx, w, r, tx, round, logger); opErr != nil {
+		return
+	}
+
+	// Step 2: Validate and apply the corrections, rescoring the round if it is completed
+	entryErrs, opErr := applyScoreCorrections(ctx, tx, game, round, bidChanges, trickChanges, krakenDiscardedTricks)
+	if opErr != nil {
+		respondActionError(w, r, opErr, "Failed to correct scores")
+		return
+	}
+	if len(entryErrs) > 0 {
+		opErr = errors.New("score corrections failed validation")
+		FieldErrorResponse(w, r, toFieldErrors(entryErrs))
+		return
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for score correction: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize score correction")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for score correction")
+	publishRoundEvent(ctx, round.GameID, apiModels.GameEventRoundUpdated, round.RoundID, round.RoundNumber, round.Status)
+
+	respondWithRound(w, r, round.RoundID, http.StatusOK, "Scores corrected successfully", logger)
+}
+
+// Handles recording a bonus captured during a round. Bonuses can be recorded while the round is
+// being played or once it is completed, in which case the round is rescored.
+// Path: /rounds/{round_id}/bonuses
+// Method: POST
+func (rh *RoundHandler) HandleRecordBonusEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundHandlerComponent,
+		"HandleRecordBonusEvent",
+	)
+
+	round, game, ok := getRoundAndCheckScorekeeper(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+	if round.Status == dbModels.RoundStatusBidding {
+		ErrorResponse(w, r, http.StatusConflict, "Bonuses can only be recorded once bidding is complete")
+		return
+	}
+
+	var req apiModels.RecordBonusEventRequest
+	if !ParseJSON(w, r, &req) {
+		return
+	}
+	logger = logger.With().Str(l.GamePlayerIDKey, req.GamePlayerID).Str(l.BonusTypeKey, req.BonusType).Logger()
+
+	// Step 1: Validate the bonus and that its players are in the round
+	event := scoring.BonusEvent{BonusType: req.BonusType, GamePlayerID: req.GamePlayerID}
+	if req.AllyGamePlayerID != nil {
+		event.AllyGamePlayerID = *req.AllyGamePlayerID
+	}
+	if err := scoring.ValidateBonusEvent(event); err != nil {
+		ErrorResponse(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !gameRuleset(game).AllowsBonusType(event.BonusType) {
+		ErrorResponse(w, r, http.StatusBadRequest, scoring.ErrBonusTypeNotInRuleset.Error())
+		return
+	}
+	bids, err := db.GetPlayerRoundScoresByRoundID(ctx, nil, round.RoundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch round players for bonus event")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve round players")
+		return
+	}
+	inRound := make(map[string]bool, len(bids))
+	for _, bid := range bids {
+		inRound[bid.GamePlayerID] = true
+	}
+	if !inRound[event.GamePlayerID] || (event.AllyGamePlayerID != "" && !inRound[event.AllyGamePlayerID]) {
+		ErrorResponse(w, r, http.StatusBadRequest, "Bonus players must be taking part in this round")
+		return
+	}
+
+	tx, txOk := StartScoringTx(ctx, w, r, logger, game.CurrentScorekeeperUserID.String, "Failed to start transaction for recording bonus event")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing bonus event")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkRoundVersion(ctx, w, r, tx, round, logger); opErr != nil {
+		return
+	}
+
+	// Step 2: Check the bonus fits with those already recorded in the round
+	if opErr = checkRoundBonusLimits(ctx, tx, round, event); opErr != nil {
+		respondActionError(w, r, opErr, "Failed to validate bonus")
+		return
+	}
+
+	// Step 3: Record the bonus
+	_, opErr = db.CreateRoundBonusEvent(ctx, tx, round.RoundID, req.GamePlayerID, req.BonusType, req.AllyGamePlayerID)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to record bonus")
+		return
+	}
+
+	// Step 4: Rescore the round if it was already completed
+	if opErr = rescoreCompletedRound(ctx, tx, game, round); opErr != nil {
+		respondActionError(w, r, opErr, "Failed to rescore round")
+		return
+	}
+
+	// Step 5: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for bonus event: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize bonus event")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for bonus event")
+	publishRoundEvent(ctx, round.GameID, apiModels.GameEventRoundUpdated, round.RoundID, round.RoundNumber, round.Status)
+
+	respondWithRound(w, r, round.RoundID, http.StatusCreated, "Bonus recorded successfully", logger)
+}
+
+// Handles removing a bonus recorded in error, rescoring the round if it is completed
+// Path: /rounds/{round_id}/bonuses/{bonus_event_id}
+// Method: DELETE
+func (rh *RoundHandler) HandleDeleteBonusEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		roundHandlerComponent,
+		"HandleDeleteBonusEvent",
+	)
+
+	round, game, ok := getRoundAndCheckScorekeeper(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+
+	bonusEventID, ok := PathVar(w, r, "bonus_event_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.BonusEventIDKey, bonusEventID).Logger()
+
+	tx, txOk := StartScoringTx(ctx, w, r, logger, game.CurrentScorekeeperUserID.String, "Failed to start transaction for deleting bonus event")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing bonus event removal")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	if opErr = checkRoundVersion(ctx, w, r, tx, round, logger); opErr != nil {
+		return
+	}
+
+	// Step 1: Delete the bonus
+	if opErr = db.DeleteRoundBonusEvent(ctx, tx, round.RoundID, bonusEventID); opErr != nil {
+		if errors.Is(opErr, db.ErrRoundBonusEventNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Bonus not found in this round")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to remove bonus")
+		}
+		return
+	}
+
+	// Step 2: Rescore the round if it was already completed
+	if opErr = rescoreCompletedRound(ctx, tx, game, round); opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to rescore round")
+		return
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for bonus event removal: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize bonus removal")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for bonus event removal")
+	publishRoundEvent(ctx, round.GameID, apiModels.GameEventRoundUpdated, round.RoundID, round.RoundNumber, round.Status)
+
+	respondWithRound(w, r, round.RoundID, http.StatusOK, "Bonus removed successfully", logger)
+}
+
+// The number, cards dealt and dealer of the round to start next in a game
+type plannedRound struct {
+	RoundNumber int
+	CardsDealt  int
+	DealerID    string
+}
+
+// Works out the next regular or tiebreaker round of a game. Returns an `*actionError` when the
+// game's state doesn't allow starting it.
+func planNextRound(
+	ctx context.Context,
+	tx *sql.Tx,
+	game *dbModels.Game,
+	isTiebreaker bool,
+	logger zerolog.Logger,
+) (*plannedRound, error) {
+	if !isGameInProgress(game) {
+		return nil, &actionError{status: http.StatusConflict, message: "Rounds can only be added to an active game"}
+	}
+	if isTiebreaker && game.TiebreakerRule != games.TiebreakerRuleTiebreakerRound {
+		return nil, &actionError{status: http.StatusConflict, message: "This game does not use tiebreaker rounds"}
+	}
+
+	// Determine the next round number and the previous dealer
+	nextRoundNumber := 1
+	previousDealerID := ""
+	latestRound, err := db.GetLatestRoundByGameID(ctx, tx, game.GameID)
+	if err == nil {
+		if latestRound.Status != dbModels.RoundStatusCompleted {
+			return nil, &actionError{status: http.StatusConflict, message: "The current round must be completed before starting a new one"}
+		}
+		nextRoundNumber = latestRound.RoundNumber + 1
+		previousDealerID = latestRound.DealerGamePlayerID
+	} else if !errors.Is(err, db.ErrRoundNotFound) {
+		logger.Error().Err(err).Msg("Failed to fetch latest round for game")
+		return nil, err
+	}
+	roundCount, err := games.RoundCount(game.RoundSchedule)
+	if err != nil {
+		logger.Error().Err(err).Str("round_schedule", game.RoundSchedule).Msg("Game has an unknown round schedule")
+		return nil, err
+	}
+	if !isTiebreaker && nextRoundNumber > roundCount {
+		return nil, &actionError{status: http.StatusConflict, message: "All rounds for this game have already been played"}
+	}
+	if isTiebreaker && nextRoundNumber <= roundCount {
+		return nil, &actionError{status: http.StatusConflict, message: "A tiebreaker round can only be played once every round is complete"}
+	}
+
+	// Look up the cards dealt in the round from the game's round schedule
+	var cardsDealt int
+	if isTiebreaker {
+		cardsDealt, err = games.TiebreakerCardsDealt(game.RoundSchedule)
+	} else {
+		cardsDealt, err = games.CardsDealtForRound(game.RoundSchedule, nextRoundNumber)
+	}
+	if err != nil {
+		logger.Error().Err(err).Str("round_schedule", game.RoundSchedule).Msg("Failed to look up cards dealt for round")
+		return nil, err
+	}
+
+	// Rotate the deal clockwise, skipping players who have left
+	players, err := db.GetGamePlayersByGameID(ctx, tx, game.GameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch players for round creation")
+		return nil, err
+	}
+	if !game.StartingDealerGamePlayerID.Valid {
+		logger.Error().Msg("Active game has no starting dealer")
+		return nil, &actionError{status: http.StatusConflict, message: "This game has no starting dealer"}
+	}
+	dealerID, err := games.DealerForRound(seatsFromPlayers(players), game.StartingDealerGamePlayerID.String, previousDealerID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Could not determine the dealer for the round")
+		return nil, &actionError{status: http.StatusConflict, message: "Could not determine the dealer for this round"}
+	}
+
+	// A tiebreaker round needs at least two players still tied for first place
+	if isTiebreaker {
+		tied, err := tiebreakerParticipants(ctx, tx, game.GameID, players)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to determine tied players")
+			return nil, err
+		}
+		if len(tied) < 2 {
+			return nil, &actionError{status: http.StatusConflict, message: "There is no tie for first place to break"}
+		}
+	}
+
+	return &plannedRound{RoundNumber: nextRoundNumber, CardsDealt: cardsDealt, DealerID: dealerID}, nil
+}
+
+// Validates the bids for a round: exactly one bid within the cards dealt for every player
+// taking part in it. Returns an `*actionError` listing the invalid entries.
+func validateRoundBids(
+	ctx context.Context,
+	tx *sql.Tx,
+	round *dbModels.Round,
+	bids []apiModels.PlayerBid,
+	logger zerolog.Logger,
+) error {
+	players, err := db.GetGamePlayersByGameID(ctx, tx, round.GameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch players for bid submission")
+		return err
+	}
+	expectedPlayerIDs, err := roundParticipants(ctx, tx, round, players)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to determine round participants")
+		return err
+	}
+	entries := make([]games.PlayerEntry, 0, len(bids))
+	for _, bid := range bids {
+		entries = append(entries, games.PlayerEntry{GamePlayerID: bid.GamePlayerID, Value: bid.BidAmount})
+	}
+	if entryErrs := games.ValidateBids(entries, expectedPlayerIDs, round.CardsDealt); len(entryErrs) > 0 {
+		logger.Debug().Int(l.CountKey, len(entryErrs)).Msg("Bid submission failed validation")
+		return &actionError{status: http.StatusBadRequest, message: "Validation failed", fieldErrors: toFieldErrors(entryErrs)}
+	}
+	return nil
+}
+
+// Saves validated bids for a round and moves it from bidding to playing. Bids players have
+// submitted themselves must be revealed first, so they aren't overwritten unseen. The round is
+// read again in the transaction, the caller's copy may be from before a player bid or the
+// scorekeeper revealed the bids.
+func saveRoundBids(ctx context.Context, tx *sql.Tx, round *dbModels.Round, bids []apiModels.PlayerBid) error {
+	current, err := db.GetRoundByID(ctx, tx, round.RoundID)
+	if err != nil {
+		return err
+	}
+	if current.Status != dbModels.RoundStatusBidding {
+		return &actionError{status: http.StatusConflict, message: "This round is no longer accepting bids"}
+	}
+	if bidsHidden(current) {
+		existing, err := db.GetPlayerRoundScoresByRoundID(ctx, tx, round.RoundID)
+		if err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			return &actionError{
+				status:  http.StatusConflict,
+				message: "Players have already submitted hidden bids for this round, reveal them before entering bids",
+			}
+		}
+	}
+	for _, bid := range bids {
+		if err := db.UpsertPlayerRoundBid(ctx, tx, round.RoundID, bid.GamePlayerID, bid.BidAmount); err != nil {
+			return err
+		}
+	}
+	err = db.UpdateRoundStatus(ctx, tx, round.RoundID, dbModels.RoundStatusBidding, dbModels.RoundStatusPlaying)
+	if errors.Is(err, db.ErrRoundStatusConflict) {
+		return &actionError{status: http.StatusConflict, message: "This round is no longer accepting bids"}
+	}
+	return err
+}
+
+// Validates the tricks taken in a round: exactly one result for every player who bid, adding up
+// to the tricks played. Returns an `*actionError` listing the invalid entries.
+func validateRoundTricks(
+	ctx context.Context,
+	tx *sql.Tx,
+	game *dbModels.Game,
+	round *dbModels.Round,
+	req *apiModels.SubmitTricksRequest,
+	logger zerolog.Logger,
+) error {
+	bids, err := db.GetPlayerRoundScoresByRoundID(ctx, tx, round.RoundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch bids for trick submission")
+		return err
+	}
+	expectedPlayerIDs := make(map[string]bool, len(bids))
+	for _, bid := range bids {
+		expectedPlayerIDs[bid.GamePlayerID] = true
+	}
+	entries := make([]games.PlayerEntry, 0, len(req.Tricks))
+	for _, result := range req.Tricks {
+		entries = append(entries, games.PlayerEntry{GamePlayerID: result.GamePlayerID, Value: result.TricksTaken})
+	}
+	entryErrs := games.ValidateTricks(
+		entries,
+		expectedPlayerIDs,
+		round.CardsDealt,
+		req.KrakenDiscardedTricks,
+		gameRuleset(game).MaxDiscardedTricks(),
+	)
+	if len(entryErrs) > 0 {
+		logger.Debug().Int(l.CountKey, len(entryErrs)).Msg("Trick submission failed validation")
+		return &actionError{status: http.StatusBadRequest, message: "Validation failed", fieldErrors: toFieldErrors(entryErrs)}
+	}
+	return nil
+}
+
+// Scores validated tricks taken for a round, including the bonuses recorded during it, and
+// moves it from playing to completed
+func saveRoundTricks(
+	ctx context.Context,
+	tx *sql.Tx,
+	game *dbModels.Game,
+	round *dbModels.Round,
+	req *apiModels.SubmitTricksRequest,
+) error {
+	tricksTaken := make(map[string]int, len(req.Tricks))
+	for _, result := range req.Tricks {
+		tricksTaken[result.GamePlayerID] = result.TricksTaken
+	}
+	if err := scoreRound(ctx, tx, game, round, tricksTaken); err != nil {
+		return err
+	}
+	if req.KrakenDiscardedTricks != round.KrakenDiscardedTricks {
+		if err := db.SetRoundKrakenDiscardedTricks(ctx, tx, round.RoundID, req.KrakenDiscardedTricks); err != nil {
+			return err
+		}
+	}
+	err := db.UpdateRoundStatus(ctx, tx, round.RoundID, dbModels.RoundStatusPlaying, dbModels.RoundStatusCompleted)
+	if errors.Is(err, db.ErrRoundStatusConflict) {
+		return &actionError{status: http.StatusConflict, message: "This round is no longer being played"}
+	}
+	return err
+}
+
+// Scores every player in a round from the tricks they took and the bonuses recorded for the
+// round. tricksTaken must contain every player who bid in the round.
+func scoreRound(
+	ctx context.Context,
+	tx *sql.Tx,
+	game *dbModels.Game,
+	round *dbModels.Round,
+	tricksTaken map[string]int,
+) error {
+	bids, err := db.GetPlayerRoundScoresByRoundID(ctx, tx, round.RoundID)
+	if err != nil {
+		return err
+	}
+	dbEvents, err := db.GetRoundBonusEventsByRoundID(ctx, tx, round.RoundID)
+	if err != nil {
+		return err
+	}
+
+	bidMade := make(map[string]bool, len(bids))
+	for _, bid := range bids {
+		tricks, ok := tricksTaken[bid.GamePlayerID]
+		if !ok {
+			return fmt.Errorf("no tricks taken for player %s in round %s", bid.GamePlayerID, round.RoundID)
+		}
+		bidMade[bid.GamePlayerID] = scoring.BidMade(bid.BidAmount, tricks)
+	}
+
+	events := toScoringBonusEvents(dbEvents)
+	if err := scoring.ValidateRoundBonuses(events, tricksTaken); err != nil {
+		return &actionError{status: http.StatusBadRequest, message: err.Error()}
+	}
+	bonusPoints, err := scoring.RoundBonusPoints(events, bidMade)
+	if err != nil {
+		return err
+	}
+
+	for _, bid := range bids {
+		_, err := db.RecordPlayerRoundResult(
+			ctx,
+			tx,
+			round.RoundID,
+			bid.GamePlayerID,
+			gameRuleset(game),
+			round.CardsDealt,
+			tricksTaken[bid.GamePlayerID],
+			bonusPoints[bid.GamePlayerID],
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Checks a new bonus against the bonuses already recorded in a round and the tricks taken so
+// far, returning an `*actionError` if the round can't have it
+func checkRoundBonusLimits(ctx context.Context, tx *sql.Tx, round *dbModels.Round, event scoring.BonusEvent) error {
+	dbEvents, err := db.GetRoundBonusEventsByRoundID(ctx, tx, round.RoundID)
+	if err != nil {
+		return err
+	}
+	scores, err := db.GetPlayerRoundScoresByRoundID(ctx, tx, round.RoundID)
+	if err != nil {
+		return err
+	}
+
+	events := append(toScoringBonusEvents(dbEvents), event)
+	if err := scoring.ValidateRoundBonuses(events, recordedTricksTaken(scores)); err != nil {
+		return &actionError{status: http.StatusConflict, message: err.Error()}
+	}
+	return nil
+}
+
+// Applies corrected bids and tricks taken to a round that is no longer bidding. The corrected
+// round is validated as a whole, returning the validation errors without changing anything if
+// it is invalid. Completed rounds are rescored with the corrected values.
+func applyScoreCorrections(
+	ctx context.Context,
+	tx *sql.Tx,
+	game *dbModels.Game,
+	round *dbModels.Round,
+	bidChanges, trickChanges map[string]int,
+	krakenDiscardedTricks int,
+) ([]games.EntryError, error) {
+	scores, err := db.GetPlayerRoundScoresByRoundID(ctx, tx, round.RoundID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants := make(map[string]bool, len(scores))
+	bids := make([]games.PlayerEntry, 0, len(scores))
+	tricks := make([]games.PlayerEntry, 0, len(scores))
+	tricksTaken := make(map[string]int, len(scores))
+	for _, score := range scores {
+		participants[score.GamePlayerID] = true
+
+		bid := score.BidAmount
+		if corrected, ok := bidChanges[score.GamePlayerID]; ok {
+			bid = corrected
+		}
+		bids = append(bids, games.PlayerEntry{GamePlayerID: score.GamePlayerID, Value: bid})
+
+		taken := int(score.TricksTaken.Int32)
+		if corrected, ok := trickChanges[score.GamePlayerID]; ok {
+			taken = corrected
+		}
+		tricks = append(tricks, games.PlayerEntry{GamePlayerID: score.GamePlayerID, Value: taken})
+		tricksTaken[score.GamePlayerID] = taken
+	}
+
+	// Corrections for players outside the round are reported by the validators
+	for gamePlayerID, bid := range bidChanges {
+		if !participants[gamePlayerID] {
+			bids = append(bids, games.PlayerEntry{GamePlayerID: gamePlayerID, Value: bid})
+		}
+	}
+	for gamePlayerID, taken := range trickChanges {
+		if !participants[gamePlayerID] {
+			tricks = append(tricks, games.PlayerEntry{GamePlayerID: gamePlayerID, Value: taken})
+		}
+	}
+
+	entryErrs := games.ValidateBids(bids, participants, round.CardsDealt)
+	if round.Status == dbModels.RoundStatusCompleted {
+		entryErrs = append(entryErrs, games.ValidateTricks(
+			tricks,
+			participants,
+			round.CardsDealt,
+			krakenDiscardedTricks,
+			gameRuleset(game).MaxDiscardedTricks(),
+		)...)
+	}
+	if len(entryErrs) > 0 {
+		return entryErrs, nil
+	}
+
+	for gamePlayerID, bid := range bidChanges {
+		if err := db.UpdatePlayerRoundBid(ctx, tx, round.RoundID, gamePlayerID, bid); err != nil {
+			return nil, err
+		}
+	}
+	if krakenDiscardedTricks != round.KrakenDiscardedTricks {
+		if err := db.SetRoundKrakenDiscardedTricks(ctx, tx, round.RoundID, krakenDiscardedTricks); err != nil {
+			return nil, err
+		}
+	}
+	if round.Status == dbModels.RoundStatusCompleted {
+		if err := scoreRound(ctx, tx, game, round, tricksTaken); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// Rescores a completed round from its recorded tricks taken, rounds still being played are
+// scored when their tricks are submitted
+func rescoreCompletedRound(ctx context.Context, tx *sql.Tx, game *dbModels.Game, round *dbModels.Round) error {
+	if round.Status != dbModels.RoundStatusCompleted {
+		return nil
+	}
+	scores, err := db.GetPlayerRoundScoresByRoundID(ctx, tx, round.RoundID)
+	if err != nil {
+		return err
+	}
+	return scoreRound(ctx, tx, game, round, recordedTricksTaken(scores))
+}
+
+// Collects the tricks taken by each player whose tricks have been recorded
+func recordedTricksTaken(scores []dbModels.PlayerRoundScore) map[string]int {
+	tricksTaken := make(map[string]int, len(scores))
+	for _, score := range scores {
+		if score.TricksTaken.Valid {
+			tricksTaken[score.GamePlayerID] = int(score.TricksTaken.Int32)
+		}
+	}
+	return tricksTaken
+}
+
+// Converts a round's recorded bonus events to the events scoring works with
+func toScoringBonusEvents(dbEvents []dbModels.RoundBonusEvent) []scoring.BonusEvent {
+	events := make([]scoring.BonusEvent, 0, len(dbEvents)+1)
+	for _, e := range dbEvents {
+		events = append(events, scoring.BonusEvent{
+			BonusType:        e.BonusType,
+			GamePlayerID:     e.GamePlayerID,
+			AllyGamePlayerID: e.AllyGamePlayerID.String,
+		})
+	}
+	return events
+}
+
+// Loads the round from the `round_id` path variable and verifies the authenticated user is
+// the scorekeeper of the round's game, enriching the logger with the identifiers
+func getRoundAndCheckScorekeeper(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *zerolog.Logger,
+) (*dbModels.Round, *dbModels.Game, bool) {
+	roundID, ok := PathVar(w, r, "round_id")
+	if !ok {
+		return nil, nil, false
+	}
+	*logger = logger.With().Str(l.RoundIDKey, roundID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, *logger)
+	if !authOk {
+		return nil, nil, false
+	}
+	*logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	round, err := db.GetRoundByID(ctx, nil, roundID)
+	if err != nil {
+		if errors.Is(err, db.ErrRoundNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Round not found")
+		} else {
+			logger.Error().Err(err).Msg("Failed to fetch round")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve round")
+		}
+		return nil, nil, false
+	}
+	*logger = logger.With().Str(l.GameIDKey, round.GameID).Int(l.RoundNumberKey, round.RoundNumber).Logger()
+
+	game, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, round.GameID, userID, *logger)
+	if !authorized {
+		return nil, nil, false
+	}
+	if !isGameInProgress(game) {
+		ErrorResponse(w, r, http.StatusConflict, "This game is no longer in progress")
+		return nil, nil, false
+	}
+
+	return round, game, true
+}
+
+// Fetches a round with its player scores and sends it as the API response
+func respondWithRound(
+	w http.ResponseWriter,
+	r *http.Request,
+	roundID string,
+	successStatus int,
+	successMessage string,
+	logger zerolog.Logger,
+) {
+	ctx := r.Context()
+	dbRound, err := db.GetRoundByID(ctx, nil, roundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch round for response")
+		Respond(w, r, successStatus, map[string]string{"round_id": roundID}, successMessage+", but full details could not be retrieved")
+		return
+	}
+
+	dbScores, err := db.GetPlayerRoundScoresByRoundID(ctx, nil, roundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch round scores for response")
+		Respond(w, r, successStatus, map[string]string{"round_id": roundID}, successMessage+", but full details could not be retrieved")
+		return
+	}
+
+	dbBonusEvents, err := db.GetRoundBonusEventsByRoundID(ctx, nil, roundID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch round bonus events for response")
+		Respond(w, r, successStatus, map[string]string{"round_id": roundID}, successMessage+", but full details could not be retrieved")
+		return
+	}
+
+	apiRound, convErr := modelConverters.DBRoundToAPIRound(dbRound, dbScores, dbBonusEvents)
+	if convErr != nil {
+		logger.Error().Err(convErr).Msg("Failed to convert DB round to API round for response")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to process round details")
+		return
+	}
+	w.Header().Set("ETag", versionETag(dbRound.Version))
+	setGameVersionHeader(ctx, w, gameETagHeader, dbRound.GameID, logger)
+
+	if dbRound.Status == dbModels.RoundStatusBidding {
+		players, err := db.GetGamePlayersByGameID(ctx, nil, dbRound.GameID)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to fetch players to determine next bidder, omitting it")
+			hideUnrevealedBids(apiRound, dbRound, "")
+		} else {
+			hideUnrevealedBids(apiRound, dbRound, viewerGamePlayerID(players, a.SessionUserID(r)))
+			hasBid := make(map[string]bool, len(dbScores))
+			for _, score := range dbScores {
+				hasBid[score.GamePlayerID] = true
+			}
+			seats := seatsFromPlayers(players)
+			if dbRound.IsTiebreakerRound {
+				participants, err := roundParticipants(ctx, nil, dbRound, players)
+				if err != nil {
+					logger.Error().Err(err).Msg("Failed to determine round participants, omitting next bidder")
+					Respond(w, r, successStatus, apiRound, successMessage)
+					return
+				}
+				for i := range seats {
+					seats[i].Active = participants[seats[i].GamePlayerID]
+				}
+			}
+			nextBidderID, err := games.NextBidder(seats, dbRound.DealerGamePlayerID, hasBid)
+			if err != nil {
+				logger.Warn().Err(err).Msg("Could not determine next bidder, omitting it")
+			} else if nextBidderID != "" {
+				apiRound.NextBidderGamePlayerID = &nextBidderID
+			}
+		}
+	}
+
+	Respond(w, r, successStatus, apiRound, successMessage)
+}
+
+// Reports whether the bids of a round are hidden. Bids players submit themselves stay hidden
+// while the round is bidding, until everyone has bid or the scorekeeper reveals them.
+func bidsHidden(round *dbModels.Round) bool {
+	return round.Status == dbModels.RoundStatusBidding && !round.BidsRevealedAt.Valid
+}
+
+// Removes every bid but the viewer's own from a round response while the round's bids are hidden
+func hideUnrevealedBids(apiRound *apiModels.RoundResponse, round *dbModels.Round, viewerGamePlayerID string) {
+	if !bidsHidden(round) {
+		return
+	}
+	apiRound.BidsHidden = true
+	for i := range apiRound.Scores {
+		if apiRound.Scores[i].GamePlayerID != viewerGamePlayerID {
+			apiRound.Scores[i].BidAmount = nil
+		}
+	}
+}
+
+// Returns the ID of the game player seated as the user, empty if the user isn't a player
+func viewerGamePlayerID(players []dbModels.GamePlayer, userID string) string {
+	if userID == "" {
+		return ""
+	}
+	for _, p := range players {
+		if p.UserID.Valid && p.UserID.String == userID {
+			return p.GamePlayerID
+		}
+	}
+	return ""
+}
+
+// Reports whether a game has been started and can still be scored
+func isGameInProgress(game *dbModels.Game) bool {
+	return game.Status == "active"
+}
+
+// Builds the ruleset a game is played and scored with
+func gameRuleset(game *dbModels.Game) scoring.Ruleset {
+	return scoring.Ruleset{
+		Name:       game.Ruleset,
+		Kraken:     game.KrakenEnabled,
+		WhiteWhale: game.WhiteWhaleEnabled,
+		Loot:       game.LootEnabled,
+	}
+}
+
+// Returns the IDs of the players taking part in a round, every player still at the table for a
+// regular round or only those still tied for first place for a tiebreaker round
+func roundParticipants(ctx context.Context, tx *sql.Tx, round *dbModels.Round, players []dbModels.GamePlayer) (map[string]bool, error) {
+	if round.IsTiebreakerRound {
+		return tiebreakerParticipants(ctx, tx, round.GameID, players)
+	}
+	participants := make(map[string]bool, len(players))
+	for _, p := range players {
+		if !p.LeftAt.Valid {
+			participants[p.GamePlayerID] = true
+		}
+	}
+	return participants, nil
+}
+
+// Returns the IDs of the players still at the table who are tied for first place after every
+// completed round, including any earlier tiebreaker rounds
+func tiebreakerParticipants(ctx context.Context, tx *sql.Tx, gameID string, players []dbModels.GamePlayer) (map[string]bool, error) {
+	ranked, err := rankGameStandings(ctx, tx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	participants := make(map[string]bool)
+	for _, gamePlayerID := range games.TiedLeaders(ranked) {
+		if p, ok := findGamePlayer(players, gamePlayerID); ok && !p.LeftAt.Valid {
+			participants[gamePlayerID] = true
+		}
+	}
+	return participants, nil
+}
+
+// Converts bid and trick validation errors to API field errors
+func toFieldErrors(entryErrs []games.EntryError) []apiModels.FieldError {
+	fieldErrors := make([]apiModels.FieldError, 0, len(entryErrs))
+	for _, e := range entryErrs {
+		fieldErrors = append(fieldErrors, apiModels.FieldError{
+			Field:        e.Field,
+			GamePlayerID: e.GamePlayerID,
+			Message:      e.Message,
+		})
+	}
+	return fieldErrors
+}
+
+// Builds the table seating used for dealer rotation, players must be ordered by seating order
+func seatsFromPlayers(players []dbModels.GamePlayer) []games.Seat {
+	seats := make([]games.Seat, 0, len(players))
+	for _, p := range players {
+		seats = append(seats, games.Seat{
+			GamePlayerID: p.GamePlayerID,
+			SeatingOrder: p.SeatingOrder,
+			Active:       !p.LeftAt.Valid,
+		})
+	}
+	return seats
+}
+
+// Finds a player by game player ID
+func findGamePlayer(players []dbModels.GamePlayer, gamePlayerID string) (*dbModels.GamePlayer, bool) {
+	for i := range players {
+		if players[i].GamePlayerID == gamePlayerID {
+			return &players[i], true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,487 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/rs/zerolog"
+
+	cf "github.com/seankim658/skullking/internal/config"
+	db "github.com/seankim658/skullking/internal/database"
+	l "github.com/seankim658/skullking/internal/logger"
+	apiModels "github.com/seankim658/skullking/internal/models/api"
+	modelConverters "github.com/seankim658/skullking/internal/models/convert"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const scorekeeperHandlerComponent = "handlers-scorekeeper"
+
+type ScorekeeperHandler struct {
+	Cfg *cf.Config
+}
+
+func NewScorekeeperHandler(cfg *cf.Config) *ScorekeeperHandler {
+	return &ScorekeeperHandler{Cfg: cfg}
+}
+
+// Handles the current scorekeeper handing scorekeeping to another registered player in the game
+// Path: /games/{game_id}/scorekeeper/transfer
+// Method: POST
+func (sh *ScorekeeperHandler) HandleTransferScorekeeper(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperHandlerComponent,
+		"HandleTransferScorekeeper",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	game, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger)
+	if !authorized {
+		return
+	}
+	if !isGameUnfinished(game) {
+		ErrorResponse(w, r, http.StatusConflict, "Scorekeeping can only change hands before the game is finished")
+		return
+	}
+
+	var req apiModels.TransferScorekeeperRequest
+	if !ParseJSON(w, r, &req) {
+		return
+	}
+	if !RequireFields(w, r, map[string]string{"to_user_id": req.ToUserID}) {
+		return
+	}
+	if req.ToUserID == userID {
+		ErrorResponse(w, r, http.StatusBadRequest, "You are already the scorekeeper of this game")
+		return
+	}
+	logger = logger.With().Str("to_user_id", req.ToUserID).Logger()
+
+	inGame, err := db.IsUserInGame(ctx, nil, gameID, req.ToUserID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to check if the new scorekeeper is in the game")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to verify the new scorekeeper")
+		return
+	}
+	if !inGame {
+		ErrorResponse(w, r, http.StatusBadRequest, "Scorekeeping can only be handed to a registered player in this game")
+		return
+	}
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for transferring scorekeeper")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing scorekeeper transfer")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Step 1: Hand over scorekeeping, this fails if another handoff happened first
+	if opErr = db.SetGameScorekeeper(ctx, tx, gameID, userID, req.ToUserID); opErr != nil {
+		respondHandoffError(w, r, opErr)
+		return
+	}
+
+	// Step 2: Cancel any open requests for control, they were made to the old scorekeeper
+	if opErr = db.CancelPendingScorekeeperHandoffs(ctx, tx, gameID); opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to cancel pending scorekeeper requests")
+		return
+	}
+
+	// Step 3: Record the handoff
+	var handoff *dbModels.ScorekeeperHandoff
+	handoff, opErr = db.CreateScorekeeperHandoff(
+		ctx,
+		tx,
+		gameID,
+		userID,
+		req.ToUserID,
+		dbModels.HandoffTypeTransfer,
+		dbModels.HandoffStatusCompleted,
+	)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to record scorekeeper handoff")
+		return
+	}
+
+	// Step 4: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for scorekeeper transfer: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize scorekeeper transfer")
+		return
+	}
+	committed = true
+	logger.Info().Str(l.HandoffIDKey, handoff.HandoffID).Msg("Scorekeeper transferred")
+	publishGameEvent(ctx, gameID, apiModels.GameEventScorekeeperChanged, "")
+
+	setGameETag(ctx, w, gameID, logger)
+	respondWithHandoff(w, r, handoff, http.StatusCreated, "Scorekeeper transferred successfully", logger)
+}
+
+// Handles a registered player in the game asking the current scorekeeper for control
+// Path: /games/{game_id}/scorekeeper/requests
+// Method: POST
+func (sh *ScorekeeperHandler) HandleRequestScorekeeper(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperHandlerComponent,
+		"HandleRequestScorekeeper",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	game, err := db.GetGameByID(ctx, nil, gameID)
+	if err != nil {
+		if errors.Is(err, db.ErrGameNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Game not found")
+		} else {
+			logger.Error().Err(err).Msg("Failed to fetch game for scorekeeper request")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game")
+		}
+		return
+	}
+	if !isGameUnfinished(game) {
+		ErrorResponse(w, r, http.StatusConflict, "Scorekeeping can only change hands before the game is finished")
+		return
+	}
+	if game.CurrentScorekeeperUserID.Valid && game.CurrentScorekeeperUserID.String == userID {
+		ErrorResponse(w, r, http.StatusBadRequest, "You are already the scorekeeper of this game")
+		return
+	}
+
+	inGame, err := db.IsUserInGame(ctx, nil, gameID, userID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to check if the requester is in the game")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to verify game access")
+		return
+	}
+	if !inGame {
+		ErrorResponse(w, r, http.StatusForbidden, "Only a registered player in this game can request to keep score")
+		return
+	}
+
+	handoff, err := db.CreateScorekeeperHandoff(
+		ctx,
+		nil,
+		gameID,
+		game.CurrentScorekeeperUserID.String,
+		userID,
+		dbModels.HandoffTypeRequest,
+		dbModels.HandoffStatusPending,
+	)
+	if err != nil {
+		if errors.Is(err, db.ErrScorekeeperRequestPending) {
+			ErrorResponse(w, r, http.StatusConflict, "You already have a pending request to keep score")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to request scorekeeper control")
+		}
+		return
+	}
+
+	respondWithHandoff(w, r, handoff, http.StatusCreated, "Scorekeeper control requested successfully", logger)
+}
+
+// Handles the current scorekeeper approving a request for control, handing scorekeeping to
+// the requesting player
+// Path: /games/{game_id}/scorekeeper/requests/{handoff_id}/approve
+// Method: POST
+func (sh *ScorekeeperHandler) HandleApproveScorekeeperRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperHandlerComponent,
+		"HandleApproveScorekeeperRequest",
+	)
+
+	handoff, userID, ok := getPendingRequestAndCheckScorekeeper(w, r, &logger)
+	if !ok {
+		return
+	}
+	if !handoff.ToUserID.Valid {
+		ErrorResponse(w, r, http.StatusConflict, "The requesting player no longer exists")
+		return
+	}
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for approving scorekeeper request")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing scorekeeper request approval")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Step 1: Complete the request, this fails if it was resolved by another request first
+	if opErr = db.ResolveScorekeeperHandoff(ctx, tx, handoff.HandoffID, dbModels.HandoffStatusCompleted); opErr != nil {
+		respondHandoffError(w, r, opErr)
+		return
+	}
+
+	// Step 2: Check the requester is still in the game, they may have been removed since asking
+	inGame, opErr := db.IsUserInGame(ctx, tx, handoff.GameID, handoff.ToUserID.String)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to verify the requesting player")
+		return
+	}
+	if !inGame {
+		opErr = errors.New("requesting player is no longer in the game")
+		ErrorResponse(w, r, http.StatusConflict, "The requesting player is no longer in this game")
+		return
+	}
+
+	// Step 3: Hand over scorekeeping
+	if opErr = db.SetGameScorekeeper(ctx, tx, handoff.GameID, userID, handoff.ToUserID.String); opErr != nil {
+		respondHandoffError(w, r, opErr)
+		return
+	}
+
+	// Step 4: Cancel the other open requests, they were made to the old scorekeeper
+	if opErr = db.CancelPendingScorekeeperHandoffs(ctx, tx, handoff.GameID); opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to cancel pending scorekeeper requests")
+		return
+	}
+
+	// Step 5: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for scorekeeper request approval: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize scorekeeper request approval")
+		return
+	}
+	committed = true
+	logger.Info().Msg("Scorekeeper request approved")
+	publishGameEvent(ctx, handoff.GameID, apiModels.GameEventScorekeeperChanged, "")
+
+	setGameETag(ctx, w, handoff.GameID, logger)
+	respondWithHandoffByID(w, r, handoff.GameID, handoff.HandoffID, "Scorekeeper request approved successfully", logger)
+}
+
+// Handles the current scorekeeper rejecting a request for control
+// Path: /games/{game_id}/scorekeeper/requests/{handoff_id}/reject
+// Method: POST
+func (sh *ScorekeeperHandler) HandleRejectScorekeeperRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperHandlerComponent,
+		"HandleRejectScorekeeperRequest",
+	)
+
+	handoff, _, ok := getPendingRequestAndCheckScorekeeper(w, r, &logger)
+	if !ok {
+		return
+	}
+
+	if err := db.ResolveScorekeeperHandoff(ctx, nil, handoff.HandoffID, dbModels.HandoffStatusRejected); err != nil {
+		respondHandoffError(w, r, err)
+		return
+	}
+
+	respondWithHandoffByID(w, r, handoff.GameID, handoff.HandoffID, "Scorekeeper request rejected successfully", logger)
+}
+
+// Handles listing the scorekeeper handoffs and requests of a game
+// Path: /games/{game_id}/scorekeeper/handoffs
+// Method: GET
+func (sh *ScorekeeperHandler) HandleGetScorekeeperHandoffs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		scorekeeperHandlerComponent,
+		"HandleGetScorekeeperHandoffs",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	if _, authorized := CheckGameViewAccess(ctx, w, r, gameID, userID, logger); !authorized {
+		return
+	}
+
+	dbHandoffs, err := db.GetScorekeeperHandoffsByGameID(ctx, nil, gameID)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve scorekeeper handoffs")
+		return
+	}
+
+	apiHandoffs := make([]apiModels.ScorekeeperHandoffResponse, 0, len(dbHandoffs))
+	for i := range dbHandoffs {
+		apiHandoff, convErr := modelConverters.DBHandoffToAPIHandoff(&dbHandoffs[i])
+		if convErr != nil {
+			logger.Error().Err(convErr).Msg("Failed to convert DB scorekeeper handoff to API scorekeeper handoff")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to process scorekeeper handoffs")
+			return
+		}
+		apiHandoffs = append(apiHandoffs, *apiHandoff)
+	}
+
+	Respond(w, r, http.StatusOK, apiHandoffs, "Scorekeeper handoffs retrieved successfully")
+}
+
+// Loads the pending scorekeeper request from the path variables and verifies the authenticated
+// user is the game's current scorekeeper, enriching the logger with the identifiers
+func getPendingRequestAndCheckScorekeeper(
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *zerolog.Logger,
+) (*dbModels.ScorekeeperHandoff, string, bool) {
+	ctx := r.Context()
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return nil, "", false
+	}
+	handoffID, ok := PathVar(w, r, "handoff_id")
+	if !ok {
+		return nil, "", false
+	}
+	*logger = logger.With().Str(l.GameIDKey, gameID).Str(l.HandoffIDKey, handoffID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, *logger)
+	if !authOk {
+		return nil, "", false
+	}
+	*logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	game, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, *logger)
+	if !authorized {
+		return nil, "", false
+	}
+	if !isGameUnfinished(game) {
+		ErrorResponse(w, r, http.StatusConflict, "Scorekeeping can only change hands before the game is finished")
+		return nil, "", false
+	}
+
+	handoff, err := db.GetScorekeeperHandoff(ctx, nil, gameID, handoffID)
+	if err != nil {
+		if errors.Is(err, db.ErrScorekeeperHandoffNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Scorekeeper request not found")
+		} else {
+			logger.Error().Err(err).Msg("Failed to fetch scorekeeper handoff")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve scorekeeper request")
+		}
+		return nil, "", false
+	}
+	if handoff.HandoffType != dbModels.HandoffTypeRequest {
+		ErrorResponse(w, r, http.StatusBadRequest, "Only a request for control can be approved or rejected")
+		return nil, "", false
+	}
+	if handoff.Status != dbModels.HandoffStatusPending {
+		ErrorResponse(w, r, http.StatusConflict, "This scorekeeper request has already been resolved")
+		return nil, "", false
+	}
+
+	return handoff, userID, true
+}
+
+// Writes the response for the handoff errors that come from concurrent handoffs
+func respondHandoffError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, db.ErrScorekeeperChanged):
+		ErrorResponse(w, r, http.StatusConflict, "The scorekeeper of this game has changed")
+	case errors.Is(err, db.ErrScorekeeperHandoffResolved):
+		ErrorResponse(w, r, http.StatusConflict, "This scorekeeper request has already been resolved")
+	default:
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to update scorekeeper")
+	}
+}
+
+// Sends a scorekeeper handoff as the API response
+func respondWithHandoff(
+	w http.ResponseWriter,
+	r *http.Request,
+	handoff *dbModels.ScorekeeperHandoff,
+	successStatus int,
+	successMessage string,
+	logger zerolog.Logger,
+) {
+	apiHandoff, convErr := modelConverters.DBHandoffToAPIHandoff(handoff)
+	if convErr != nil {
+		logger.Error().Err(convErr).Msg("Failed to convert DB scorekeeper handoff to API scorekeeper handoff for response")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to process scorekeeper handoff details")
+		return
+	}
+	Respond(w, r, successStatus, apiHandoff, successMessage)
+}
+
+// Fetches a scorekeeper handoff and sends it as the API response
+func respondWithHandoffByID(
+	w http.ResponseWriter,
+	r *http.Request,
+	gameID, handoffID string,
+	successMessage string,
+	logger zerolog.Logger,
+) {
+	handoff, err := db.GetScorekeeperHandoff(r.Context(), nil, gameID, handoffID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch scorekeeper handoff for response")
+		Respond(w, r, http.StatusOK, map[string]string{"handoff_id": handoffID}, successMessage+", but full details could not be retrieved")
+		return
+	}
+	respondWithHandoff(w, r, handoff, http.StatusOK, successMessage, logger)
+}
+
+// Reports whether a game has not yet been completed or abandoned
+func isGameUnfinished(game *dbModels.Game) bool {
+	return game.Status == "pending" || game.Status == "active"
+}
@@ -125,3 +125,121 @@ func (sh *SessionHandler) HandleCompleteSession(w http.ResponseWriter, r *http.R
 
 	Respond(w, r, http.StatusOK, nil, "Session marked as completed sucessfully")
 }
+
+// Abandons a session along with its pending and active games, with an optional reason. Only
+// the creator of the session can abandon it.
+// Path: /sessions/{session_id}/abandon
+// Method: PUT
+func (sh *SessionHandler) HandleAbandonSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		sessionHandlerComponent,
+		"HandleAbandonSession",
+	)
+
+	sessionID, ok := PathVar(w, r, "session_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.SessionIDKey, sessionID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	session, err := db.GetSessionByID(ctx, nil, sessionID)
+	if err != nil {
+		if errors.Is(err, db.ErrSessionNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Session not found")
+		} else {
+			logger.Error().Err(err).Msg("Failed to fetch session")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve session")
+		}
+		return
+	}
+	if !session.CreatedByUserID.Valid || session.CreatedByUserID.String != userID {
+		logger.Warn().Msg("User is not the creator of the session")
+		ErrorResponse(w, r, http.StatusForbidden, "You are not authorized to modify this session")
+		return
+	}
+	if session.Status != "active" {
+		ErrorResponse(w, r, http.StatusConflict, "Only an active session can be abandoned")
+		return
+	}
+
+	// The request body is optional
+	var req apiModels.AbandonRequest
+	if r.ContentLength != 0 && !ParseJSON(w, r, &req) {
+		return
+	}
+	reason, reasonOk := OptionalReason(w, r, req.Reason)
+	if !reasonOk {
+		return
+	}
+
+	tx, txOk := StartTx(ctx, w, r, logger, "Failed to start transaction for abandoning session")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing session abandonment")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Step 1: Abandon the session, this fails if another request finished it first
+	if opErr = db.AbandonSession(ctx, tx, sessionID, reason); opErr != nil {
+		if errors.Is(opErr, db.ErrSessionStatusConflict) {
+			ErrorResponse(w, r, http.StatusConflict, "Only an active session can be abandoned")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to abandon session")
+		}
+		return
+	}
+
+	// Step 2: Abandon the session's unfinished games
+	var abandonedGameIDs []string
+	abandonedGameIDs, opErr = db.AbandonSessionGames(ctx, tx, sessionID, reason)
+	if opErr != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to abandon session games")
+		return
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for abandoning session: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize session abandonment")
+		return
+	}
+	committed = true
+	logger.Info().Int(l.CountKey, len(abandonedGameIDs)).Msg("Session abandoned successfully")
+	for _, abandonedGameID := range abandonedGameIDs {
+		publishGameEvent(ctx, abandonedGameID, apiModels.GameEventStatusChanged, "abandoned")
+	}
+
+	if abandonedGameIDs == nil {
+		abandonedGameIDs = []string{}
+	}
+	response := apiModels.AbandonSessionResponse{
+		SessionID:        sessionID,
+		Status:           "abandoned",
+		AbandonReason:    reason,
+		AbandonedGameIDs: abandonedGameIDs,
+	}
+	Respond(w, r, http.StatusOK, response, "Session abandoned successfully")
+}
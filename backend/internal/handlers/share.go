@@ -0,0 +1,490 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	a "github.com/seankim658/skullking/internal/auth"
+	cf "github.com/seankim658/skullking/internal/config"
+	db "github.com/seankim658/skullking/internal/database"
+	l "github.com/seankim658/skullking/internal/logger"
+	apiModels "github.com/seankim658/skullking/internal/models/api"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const shareHandlerComponent = "handlers-share"
+
+type ShareHandler struct {
+	Cfg *cf.Config
+}
+
+func NewShareHandler(cfg *cf.Config) *ShareHandler {
+	return &ShareHandler{Cfg: cfg}
+}
+
+// Handles the scorekeeper creating a read-only share link for a game
+// Path: /games/{game_id}/share-links
+// Method: POST
+func (sh *ShareHandler) HandleCreateGameShareLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareHandlerComponent,
+		"HandleCreateGameShareLink",
+	)
+
+	gameID, userID, ok := sh.checkGameShareAccess(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+
+	link, err := db.CreateShareLink(ctx, nil, gameID, "", userID)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to create share link")
+		return
+	}
+
+	Respond(w, r, http.StatusCreated, sh.toShareLinkResponse(link), "Share link created successfully")
+}
+
+// Handles the scorekeeper retrieving the share links of a game that haven't been revoked
+// Path: /games/{game_id}/share-links
+// Method: GET
+func (sh *ShareHandler) HandleGetGameShareLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareHandlerComponent,
+		"HandleGetGameShareLinks",
+	)
+
+	gameID, _, ok := sh.checkGameShareAccess(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+
+	sh.respondWithShareLinks(w, r, gameID, "")
+}
+
+// Handles the scorekeeper revoking a share link of a game, its token stops working right away
+// Path: /games/{game_id}/share-links/{share_link_id}
+// Method: DELETE
+func (sh *ShareHandler) HandleRevokeGameShareLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareHandlerComponent,
+		"HandleRevokeGameShareLink",
+	)
+
+	gameID, _, ok := sh.checkGameShareAccess(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+
+	sh.revokeShareLink(w, r, gameID, "", logger)
+}
+
+// Handles the creator of a session creating a read-only share link for every game in it,
+// including games started after the link was shared
+// Path: /sessions/{session_id}/share-links
+// Method: POST
+func (sh *ShareHandler) HandleCreateSessionShareLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareHandlerComponent,
+		"HandleCreateSessionShareLink",
+	)
+
+	sessionID, userID, ok := sh.checkSessionShareAccess(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+
+	link, err := db.CreateShareLink(ctx, nil, "", sessionID, userID)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to create share link")
+		return
+	}
+
+	Respond(w, r, http.StatusCreated, sh.toShareLinkResponse(link), "Share link created successfully")
+}
+
+// Handles the creator of a session retrieving its share links that haven't been revoked
+// Path: /sessions/{session_id}/share-links
+// Method: GET
+func (sh *ShareHandler) HandleGetSessionShareLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareHandlerComponent,
+		"HandleGetSessionShareLinks",
+	)
+
+	sessionID, _, ok := sh.checkSessionShareAccess(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+
+	sh.respondWithShareLinks(w, r, "", sessionID)
+}
+
+// Handles the creator of a session revoking one of its share links
+// Path: /sessions/{session_id}/share-links/{share_link_id}
+// Method: DELETE
+func (sh *ShareHandler) HandleRevokeSessionShareLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareHandlerComponent,
+		"HandleRevokeSessionShareLink",
+	)
+
+	sessionID, _, ok := sh.checkSessionShareAccess(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+
+	sh.revokeShareLink(w, r, "", sessionID, logger)
+}
+
+// Handles a spectator opening a share link, listing the games it gives access to. No account
+// is needed.
+// Path: /shared/{token}
+// Method: GET
+func (sh *ShareHandler) HandleGetSharedView(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareHandlerComponent,
+		"HandleGetSharedView",
+	)
+
+	link, ok := sh.resolveShareToken(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+
+	response := apiModels.SharedViewResponse{Games: []apiModels.SharedGameSummary{}}
+	var sharedGames []dbModels.Game
+	if link.GameID.Valid {
+		response.GameID = &link.GameID.String
+		game, err := db.GetGameByID(ctx, nil, link.GameID.String)
+		if err != nil {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve shared game")
+			return
+		}
+		sharedGames = append(sharedGames, *game)
+	} else {
+		response.SessionID = &link.SessionID.String
+		session, err := db.GetSessionByID(ctx, nil, link.SessionID.String)
+		if err != nil {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve shared session")
+			return
+		}
+		if session.SessionName.Valid {
+			response.SessionName = &session.SessionName.String
+		}
+		sharedGames, err = db.GetGamesBySessionID(ctx, nil, session.SessionID)
+		if err != nil {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve shared games")
+			return
+		}
+	}
+
+	for _, game := range sharedGames {
+		summary := apiModels.SharedGameSummary{
+			GameID:    game.GameID,
+			Status:    game.Status,
+			CreatedAt: game.CreatedAt,
+		}
+		if game.StartedAt.Valid {
+			summary.StartedAt = &game.StartedAt.Time
+		}
+		if game.CompletedAt.Valid {
+			summary.CompletedAt = &game.CompletedAt.Time
+		}
+		response.Games = append(response.Games, summary)
+	}
+
+	Respond(w, r, http.StatusOK, response, "Shared view retrieved successfully")
+}
+
+// Handles a spectator retrieving the detail of a game shared with them. Bids are hidden as they
+// are from players, and registered players whose stats aren't public aren't linked to their
+// accounts.
+// Path: /shared/{token}/games/{game_id}
+// Method: GET
+func (sh *ShareHandler) HandleGetSharedGame(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareHandlerComponent,
+		"HandleGetSharedGame",
+	)
+
+	link, ok := sh.resolveShareToken(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+	game, ok := sh.sharedGame(ctx, w, r, link, &logger)
+	if !ok {
+		return
+	}
+
+	response, err := buildGameDetail(ctx, nil, game, "", logger)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game details")
+		return
+	}
+	if err := hidePrivateAccounts(ctx, response, ""); err != nil {
+		logger.Error().Err(err).Msg("Failed to check stats privacy of players")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game details")
+		return
+	}
+	w.Header().Set("ETag", versionETag(game.Version))
+	Respond(w, r, http.StatusOK, response, "Game retrieved successfully")
+}
+
+// Handles streaming a shared game's changes to a spectator as Server-Sent Events. The link is
+// checked again on every keep-alive, so open streams close shortly after it is revoked.
+// Path: /shared/{token}/games/{game_id}/events
+// Method: GET
+func (sh *ShareHandler) HandleStreamSharedGameEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		shareHandlerComponent,
+		"HandleStreamSharedGameEvents",
+	)
+
+	link, ok := sh.resolveShareToken(ctx, w, r, &logger)
+	if !ok {
+		return
+	}
+	game, ok := sh.sharedGame(ctx, w, r, link, &logger)
+	if !ok {
+		return
+	}
+
+	streamGameEvents(w, r, game.GameID, func(ctx context.Context) bool {
+		current, err := db.GetShareLinkByID(ctx, nil, link.ShareLinkID)
+		if err != nil {
+			// Keep the stream open through a failed lookup, the next keep-alive checks again
+			return !errors.Is(err, db.ErrShareLinkNotFound)
+		}
+		return !current.RevokedAt.Valid
+	}, logger)
+}
+
+// Reads the `game_id` path variable and verifies the authenticated user is the scorekeeper of
+// the game, returning the game and user IDs
+func (sh *ShareHandler) checkGameShareAccess(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *zerolog.Logger,
+) (string, string, bool) {
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return "", "", false
+	}
+	*logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, *logger)
+	if !authOk {
+		return "", "", false
+	}
+	*logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	if _, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, *logger); !authorized {
+		return "", "", false
+	}
+	return gameID, userID, true
+}
+
+// Reads the `session_id` path variable and verifies the authenticated user created the session,
+// returning the session and user IDs
+func (sh *ShareHandler) checkSessionShareAccess(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *zerolog.Logger,
+) (string, string, bool) {
+	sessionID, ok := PathVar(w, r, "session_id")
+	if !ok {
+		return "", "", false
+	}
+	*logger = logger.With().Str(l.SessionIDKey, sessionID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, *logger)
+	if !authOk {
+		return "", "", false
+	}
+	*logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	session, err := db.GetSessionByID(ctx, nil, sessionID)
+	if err != nil {
+		if errors.Is(err, db.ErrSessionNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Session not found")
+		} else {
+			logger.Error().Err(err).Msg("Failed to fetch session")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve session")
+		}
+		return "", "", false
+	}
+	if !session.CreatedByUserID.Valid || session.CreatedByUserID.String != userID {
+		logger.Warn().Msg("User is not the creator of the session")
+		ErrorResponse(w, r, http.StatusForbidden, "You are not authorized to share this session")
+		return "", "", false
+	}
+	return sessionID, userID, true
+}
+
+// Sends the share links of a game or a session that haven't been revoked
+func (sh *ShareHandler) respondWithShareLinks(w http.ResponseWriter, r *http.Request, gameID, sessionID string) {
+	links, err := db.GetActiveShareLinks(r.Context(), nil, gameID, sessionID)
+	if err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve share links")
+		return
+	}
+
+	response := make([]apiModels.ShareLinkResponse, 0, len(links))
+	for i := range links {
+		response = append(response, sh.toShareLinkResponse(&links[i]))
+	}
+	Respond(w, r, http.StatusOK, response, "Share links retrieved successfully")
+}
+
+// Revokes the share link in the `share_link_id` path variable if it belongs to the game or
+// session
+func (sh *ShareHandler) revokeShareLink(w http.ResponseWriter, r *http.Request, gameID, sessionID string, logger zerolog.Logger) {
+	ctx := r.Context()
+	shareLinkID, ok := PathVar(w, r, "share_link_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.ShareLinkIDKey, shareLinkID).Logger()
+
+	link, err := db.GetShareLinkByID(ctx, nil, shareLinkID)
+	if err != nil {
+		if errors.Is(err, db.ErrShareLinkNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Share link not found")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve share link")
+		}
+		return
+	}
+	if link.GameID.String != gameID || link.SessionID.String != sessionID {
+		logger.Warn().Msg("Share link belongs to a different game or session")
+		ErrorResponse(w, r, http.StatusNotFound, "Share link not found")
+		return
+	}
+
+	if err := db.RevokeShareLink(ctx, nil, shareLinkID); err != nil {
+		if errors.Is(err, db.ErrShareLinkNotFound) {
+			ErrorResponse(w, r, http.StatusConflict, "This share link has already been revoked")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to revoke share link")
+		}
+		return
+	}
+
+	Respond(w, r, http.StatusOK, map[string]string{"share_link_id": shareLinkID}, "Share link revoked successfully")
+}
+
+// Verifies the token in the `token` path variable and loads its share link, responding with
+// 404 for tokens that weren't signed by the server and 410 for revoked links
+func (sh *ShareHandler) resolveShareToken(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *zerolog.Logger,
+) (*dbModels.ShareLink, bool) {
+	token, ok := PathVar(w, r, "token")
+	if !ok {
+		return nil, false
+	}
+
+	shareLinkID, err := a.VerifyShareToken(sh.Cfg.ShareTokenSecret, token)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Share token failed verification")
+		ErrorResponse(w, r, http.StatusNotFound, "Share link not found")
+		return nil, false
+	}
+	*logger = logger.With().Str(l.ShareLinkIDKey, shareLinkID).Logger()
+
+	link, err := db.GetShareLinkByID(ctx, nil, shareLinkID)
+	if err != nil {
+		if errors.Is(err, db.ErrShareLinkNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Share link not found")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve share link")
+		}
+		return nil, false
+	}
+	if link.RevokedAt.Valid {
+		ErrorResponse(w, r, http.StatusGone, "This share link has been revoked")
+		return nil, false
+	}
+	return link, true
+}
+
+// Loads the game in the `game_id` path variable if the share link gives access to it, the
+// shared game itself or any game in the shared session
+func (sh *ShareHandler) sharedGame(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	link *dbModels.ShareLink,
+	logger *zerolog.Logger,
+) (*dbModels.Game, bool) {
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return nil, false
+	}
+	*logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	game, err := db.GetGameByID(ctx, nil, gameID)
+	if err != nil {
+		if errors.Is(err, db.ErrGameNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Game not found")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to retrieve game")
+		}
+		return nil, false
+	}
+
+	inGame := link.GameID.Valid && link.GameID.String == game.GameID
+	inSession := link.SessionID.Valid && game.SessionID.Valid && link.SessionID.String == game.SessionID.String
+	if !inGame && !inSession {
+		logger.Warn().Msg("Game is not shared by the share link")
+		ErrorResponse(w, r, http.StatusNotFound, "Game not found")
+		return nil, false
+	}
+	return game, true
+}
+
+// Converts a share link to its API response with the token and the URL spectators open
+func (sh *ShareHandler) toShareLinkResponse(link *dbModels.ShareLink) apiModels.ShareLinkResponse {
+	token := a.SignShareToken(sh.Cfg.ShareTokenSecret, link.ShareLinkID)
+	response := apiModels.ShareLinkResponse{
+		ShareLinkID: link.ShareLinkID,
+		Token:       token,
+		ShareURL:    strings.TrimRight(sh.Cfg.FrontendBaseURL, "/") + "/shared/" + token,
+		CreatedAt:   link.CreatedAt,
+	}
+	if link.GameID.Valid {
+		response.GameID = &link.GameID.String
+	}
+	if link.SessionID.Valid {
+		response.SessionID = &link.SessionID.String
+	}
+	return response
+}
+
@@ -2,11 +2,13 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	cf "github.com/seankim658/skullking/internal/config"
 	db "github.com/seankim658/skullking/internal/database"
 	l "github.com/seankim658/skullking/internal/logger"
 	apiModels "github.com/seankim658/skullking/internal/models/api"
+	"github.com/seankim658/skullking/internal/scoring"
 )
 
 const statsComponent = "handlers-stats"
@@ -46,3 +48,22 @@ func (sh *StatsHandler) HandleGetSiteSummaryStats(w http.ResponseWriter, r *http
 
 	Respond(w, r, http.StatusOK, apiResponse, "Site summary statistics retrieved successfully")
 }
+
+// Builds the stats filter from the `ruleset` and `exclude_asterisked` query parameters,
+// responding with an error if either is invalid
+func statsFilterFromQuery(w http.ResponseWriter, r *http.Request) (db.StatsFilter, bool) {
+	filter := db.StatsFilter{Ruleset: QueryParam(r, "ruleset")}
+	if filter.Ruleset != "" && !scoring.IsValidRuleset(filter.Ruleset) {
+		ErrorResponse(w, r, http.StatusBadRequest, "Invalid ruleset")
+		return filter, false
+	}
+	if excludeAsterisked := QueryParam(r, "exclude_asterisked"); excludeAsterisked != "" {
+		exclude, err := strconv.ParseBool(excludeAsterisked)
+		if err != nil {
+			ErrorResponse(w, r, http.StatusBadRequest, "Invalid exclude_asterisked value")
+			return filter, false
+		}
+		filter.ExcludeAsterisked = exclude
+	}
+	return filter, true
+}
@@ -0,0 +1,401 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	cf "github.com/seankim658/skullking/internal/config"
+	db "github.com/seankim658/skullking/internal/database"
+	l "github.com/seankim658/skullking/internal/logger"
+	apiModels "github.com/seankim658/skullking/internal/models/api"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+const syncHandlerComponent = "handlers-sync"
+
+// Most actions a client can sync in a single batch
+const maxSyncBatchSize = 200
+
+// Savepoint each action of a batch is applied under, so a rejected action is undone on its own
+const syncActionSavepoint = "sync_action"
+
+type SyncHandler struct {
+	Cfg *cf.Config
+}
+
+func NewSyncHandler(cfg *cf.Config) *SyncHandler {
+	return &SyncHandler{Cfg: cfg}
+}
+
+// Handles applying a batch of scorekeeping actions a client queued while offline. Actions are
+// applied in order in a single transaction. Actions applied before are skipped and rejected
+// actions are undone on their own with the reason returned, the rest of the batch still applies.
+// A rejected action isn't final, sending it again retries it, e.g. once the round it needs has
+// been started.
+// Path: /games/{game_id}/sync
+// Method: POST
+func (sh *SyncHandler) HandleSyncGame(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := l.WithComponentAndSource(
+		l.GetLoggerFromContext(ctx),
+		syncHandlerComponent,
+		"HandleSyncGame",
+	)
+
+	gameID, ok := PathVar(w, r, "game_id")
+	if !ok {
+		return
+	}
+	logger = logger.With().Str(l.GameIDKey, gameID).Logger()
+
+	userID, authOk := GetAuthenticatedUserIDFromSession(w, r, logger)
+	if !authOk {
+		return
+	}
+	logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+	if _, authorized := CheckGameAccessAndScorekeeper(ctx, w, r, gameID, userID, logger); !authorized {
+		return
+	}
+
+	var req apiModels.SyncRequest
+	if !ParseJSON(w, r, &req) {
+		return
+	}
+	if len(req.Actions) == 0 {
+		ErrorResponse(w, r, http.StatusBadRequest, "At least one action is required")
+		return
+	}
+	if len(req.Actions) > maxSyncBatchSize {
+		ErrorResponse(w, r, http.StatusBadRequest, fmt.Sprintf("A batch can contain at most %d actions", maxSyncBatchSize))
+		return
+	}
+	logger = logger.With().Int(l.CountKey, len(req.Actions)).Logger()
+
+	tx, txOk := StartScoringTx(ctx, w, r, logger, userID, "Failed to start transaction for sync")
+	if !txOk {
+		return
+	}
+
+	var opErr error
+	var committed bool
+
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error().Interface(l.PanicKey, p).Bytes(l.StackTraceKey, debug.Stack()).Msg("Panic recovered")
+			_ = tx.Rollback()
+			if opErr == nil && !committed {
+				ErrorResponse(w, r, http.StatusInternalServerError, "Critical error processing sync")
+			}
+		} else if opErr != nil {
+			logger.Warn().Err(opErr).Msg("Rolling back transaction due to error in handler logic")
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Step 1: Lock the game so concurrent syncs apply one batch at a time
+	if _, opErr = db.LockGameVersion(ctx, tx, gameID); opErr != nil {
+		if errors.Is(opErr, db.ErrGameNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Game not found")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to lock game for sync")
+		}
+		return
+	}
+
+	// Step 2: Apply each action in order, recording its outcome
+	results := make([]apiModels.SyncActionResult, 0, len(req.Actions))
+	seen := make(map[string]apiModels.SyncActionResult, len(req.Actions))
+	var publish []func()
+	for i := range req.Actions {
+		action := &req.Actions[i]
+		actionLogger := logger.With().
+			Str(l.ClientActionIDKey, action.ClientActionID).
+			Str(l.ActionTypeKey, action.Type).
+			Logger()
+
+		result := apiModels.SyncActionResult{ClientActionID: action.ClientActionID, Type: action.Type}
+		clientActionID, parseErr := uuid.Parse(action.ClientActionID)
+		if parseErr != nil {
+			results = append(results, rejectedSyncResult(result, &actionError{status: http.StatusBadRequest, message: "client_action_id must be a UUID"}))
+			continue
+		}
+		action.ClientActionID = clientActionID.String()
+
+		if previous, ok := seen[action.ClientActionID]; ok {
+			previous.Duplicate = true
+			results = append(results, previous)
+			continue
+		}
+		// Only applied actions are skipped, a rejection may not hold anymore so the action is retried
+		recorded, err := db.GetSyncAction(ctx, tx, gameID, action.ClientActionID)
+		if err == nil && recorded.Status == dbModels.SyncActionStatusApplied {
+			result.Type = recorded.ActionType
+			result.Status = recorded.Status
+			result.Duplicate = true
+			seen[action.ClientActionID] = result
+			results = append(results, result)
+			continue
+		} else if err != nil && !errors.Is(err, db.ErrSyncActionNotFound) {
+			opErr = err
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to check previously synced actions")
+			return
+		}
+
+		if !isValidSyncActionType(action.Type) {
+			results = append(results, rejectedSyncResult(result, &actionError{status: http.StatusBadRequest, message: "Unknown action type"}))
+			continue
+		}
+
+		event, applyErr := applySyncActionInSavepoint(ctx, tx, gameID, action, actionLogger)
+		var actionErr *actionError
+		if errors.As(applyErr, &actionErr) {
+			actionLogger.Info().Str("reason", actionErr.message).Msg("Sync action rejected")
+			result = rejectedSyncResult(result, actionErr)
+		} else if applyErr != nil {
+			opErr = applyErr
+			actionLogger.Error().Err(opErr).Msg("Failed to apply sync action")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to apply synced actions")
+			return
+		} else {
+			result.Status = dbModels.SyncActionStatusApplied
+			publish = append(publish, event)
+		}
+
+		reason := ""
+		if result.Reason != nil {
+			reason = *result.Reason
+		}
+		opErr = db.RecordSyncAction(ctx, tx, gameID, action.ClientActionID, action.Type, result.Status, reason, userID)
+		if opErr != nil {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to record synced actions")
+			return
+		}
+		seen[action.ClientActionID] = result
+		results = append(results, result)
+	}
+
+	// Step 3: Commit Transaction
+	if err := tx.Commit(); err != nil {
+		opErr = fmt.Errorf("failed to commit transaction for sync: %w", err)
+		logger.Error().Err(opErr).Msg("Transaction commit failed")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to finalize sync")
+		return
+	}
+	committed = true
+	logger.Debug().Msg("Transaction committed successfully for sync")
+	for _, p := range publish {
+		p()
+	}
+
+	// Step 4: Respond with every result and the game as it is after the batch
+	dbGame, err := db.GetGameByID(ctx, nil, gameID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to fetch game for sync response")
+		Respond(w, r, http.StatusOK, map[string]any{"results": results}, "Actions synced, but the game could not be retrieved")
+		return
+	}
+	detail, err := buildGameDetail(ctx, nil, dbGame, userID, logger)
+	if err != nil {
+		Respond(w, r, http.StatusOK, map[string]any{"results": results}, "Actions synced, but the game could not be retrieved")
+		return
+	}
+	w.Header().Set("ETag", versionETag(dbGame.Version))
+	Respond(w, r, http.StatusOK, apiModels.SyncResponse{Results: results, Game: *detail}, "Actions synced successfully")
+}
+
+// Reports whether an action type can be applied with a batch sync
+func isValidSyncActionType(actionType string) bool {
+	switch actionType {
+	case apiModels.SyncActionAddPlayer,
+		apiModels.SyncActionStartRound,
+		apiModels.SyncActionSubmitBids,
+		apiModels.SyncActionSubmitTricks:
+		return true
+	default:
+		return false
+	}
+}
+
+// Marks a sync result as rejected for the reason given by the action error
+func rejectedSyncResult(result apiModels.SyncActionResult, actionErr *actionError) apiModels.SyncActionResult {
+	result.Status = dbModels.SyncActionStatusRejected
+	result.Reason = &actionErr.message
+	result.FieldErrors = actionErr.fieldErrors
+	return result
+}
+
+// Applies a sync action under a savepoint, undoing only its changes if it is rejected. Returns
+// the publishing of the action's event, to be called once the batch is committed.
+func applySyncActionInSavepoint(
+	ctx context.Context,
+	tx *sql.Tx,
+	gameID string,
+	action *apiModels.SyncAction,
+	logger zerolog.Logger,
+) (func(), error) {
+	if err := db.Savepoint(ctx, tx, syncActionSavepoint); err != nil {
+		return nil, err
+	}
+
+	event, err := applySyncAction(ctx, tx, gameID, action, logger)
+	var actionErr *actionError
+	if errors.As(err, &actionErr) {
+		if rollbackErr := db.RollbackToSavepoint(ctx, tx, syncActionSavepoint); rollbackErr != nil {
+			return nil, rollbackErr
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if releaseErr := db.ReleaseSavepoint(ctx, tx, syncActionSavepoint); releaseErr != nil {
+		return nil, releaseErr
+	}
+	return event, err
+}
+
+// Applies a single sync action to a game. Returns an `*actionError` if the action can't be
+// applied to the game as it is.
+func applySyncAction(
+	ctx context.Context,
+	tx *sql.Tx,
+	gameID string,
+	action *apiModels.SyncAction,
+	logger zerolog.Logger,
+) (func(), error) {
+	game, err := db.GetGameByID(ctx, tx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch action.Type {
+	case apiModels.SyncActionAddPlayer:
+		if game.Status != "pending" {
+			return nil, &actionError{status: http.StatusConflict, message: "Players cannot be changed once the game has started"}
+		}
+		var payload apiModels.AddPlayerToGameRequest
+		if err := decodeSyncPayload(action, &payload); err != nil {
+			return nil, err
+		}
+		if _, err := addPlayerToGame(ctx, tx, gameID, &payload, false, logger); err != nil {
+			return nil, err
+		}
+		return func() {
+			publishGameEvent(ctx, gameID, apiModels.GameEventPlayersUpdated, "")
+		}, nil
+
+	case apiModels.SyncActionStartRound:
+		var payload apiModels.SyncStartRoundPayload
+		if len(action.Payload) > 0 {
+			if err := decodeSyncPayload(action, &payload); err != nil {
+				return nil, err
+			}
+		}
+		next, err := planNextRound(ctx, tx, game, payload.IsTiebreaker, logger)
+		if err != nil {
+			return nil, err
+		}
+		if action.RoundNumber != nil && *action.RoundNumber != next.RoundNumber {
+			return nil, &actionError{
+				status:  http.StatusConflict,
+				message: fmt.Sprintf("Round %d cannot be started, the next round is %d", *action.RoundNumber, next.RoundNumber),
+			}
+		}
+		roundID, err := db.CreateRound(ctx, tx, gameID, next.RoundNumber, next.CardsDealt, next.DealerID, payload.IsTiebreaker)
+		if err != nil {
+			if errors.Is(err, db.ErrRoundAlreadyExists) {
+				return nil, &actionError{status: http.StatusConflict, message: "This round has already been started"}
+			}
+			return nil, err
+		}
+		return func() {
+			publishRoundEvent(ctx, gameID, apiModels.GameEventRoundCreated, roundID, next.RoundNumber, dbModels.RoundStatusBidding)
+		}, nil
+
+	case apiModels.SyncActionSubmitBids:
+		round, err := getSyncRound(ctx, tx, game, action, dbModels.RoundStatusBidding, "This round is no longer accepting bids")
+		if err != nil {
+			return nil, err
+		}
+		var payload apiModels.SubmitBidsRequest
+		if err := decodeSyncPayload(action, &payload); err != nil {
+			return nil, err
+		}
+		if err := validateRoundBids(ctx, tx, round, payload.Bids, logger); err != nil {
+			return nil, err
+		}
+		if err := saveRoundBids(ctx, tx, round, payload.Bids); err != nil {
+			return nil, err
+		}
+		return func() {
+			publishRoundEvent(ctx, gameID, apiModels.GameEventBidsSubmitted, round.RoundID, round.RoundNumber, dbModels.RoundStatusPlaying)
+		}, nil
+
+	case apiModels.SyncActionSubmitTricks:
+		round, err := getSyncRound(ctx, tx, game, action, dbModels.RoundStatusPlaying, "Tricks can only be submitted for a round that is being played")
+		if err != nil {
+			return nil, err
+		}
+		var payload apiModels.SubmitTricksRequest
+		if err := decodeSyncPayload(action, &payload); err != nil {
+			return nil, err
+		}
+		if err := validateRoundTricks(ctx, tx, game, round, &payload, logger); err != nil {
+			return nil, err
+		}
+		if err := saveRoundTricks(ctx, tx, game, round, &payload); err != nil {
+			return nil, err
+		}
+		return func() {
+			publishRoundEvent(ctx, gameID, apiModels.GameEventTricksSubmitted, round.RoundID, round.RoundNumber, dbModels.RoundStatusCompleted)
+		}, nil
+	}
+	return nil, &actionError{status: http.StatusBadRequest, message: "Unknown action type"}
+}
+
+// Decodes the payload of a sync action, returning an `*actionError` if it is malformed
+func decodeSyncPayload(action *apiModels.SyncAction, dst any) error {
+	if len(action.Payload) == 0 {
+		return &actionError{status: http.StatusBadRequest, message: "payload is required"}
+	}
+	if err := json.Unmarshal(action.Payload, dst); err != nil {
+		return &actionError{status: http.StatusBadRequest, message: "Invalid action payload"}
+	}
+	return nil
+}
+
+// Loads the round a bids or tricks action is for, returning an `*actionError` unless the game
+// is in progress and the round is in the expected status
+func getSyncRound(
+	ctx context.Context,
+	tx *sql.Tx,
+	game *dbModels.Game,
+	action *apiModels.SyncAction,
+	expectedStatus, statusMessage string,
+) (*dbModels.Round, error) {
+	if action.RoundNumber == nil {
+		return nil, &actionError{status: http.StatusBadRequest, message: "round_number is required"}
+	}
+	if !isGameInProgress(game) {
+		return nil, &actionError{status: http.StatusConflict, message: "This game is no longer in progress"}
+	}
+	round, err := db.GetRoundByNumber(ctx, tx, game.GameID, *action.RoundNumber)
+	if err != nil {
+		if errors.Is(err, db.ErrRoundNotFound) {
+			return nil, &actionError{status: http.StatusNotFound, message: "Round not found"}
+		}
+		return nil, err
+	}
+	if round.Status != expectedStatus {
+		return nil, &actionError{status: http.StatusConflict, message: statusMessage}
+	}
+	return round, nil
+}
@@ -121,7 +121,11 @@ func (uph *UserProfileHandler) HandleGetUserProfile(w http.ResponseWriter, r *ht
 
 	if canViewStats {
 		logger.Debug().Msg("Viewer has permission to see stats for this profile")
-		dbUserStats, statsErr := db.GetUserBasicStats(ctx, nil, profileUserIDFromPath)
+		statsFilter, filterOk := statsFilterFromQuery(w, r)
+		if !filterOk {
+			return
+		}
+		dbUserStats, statsErr := db.GetUserBasicStats(ctx, nil, profileUserIDFromPath, statsFilter)
 		if statsErr != nil {
 			logger.Error().Err(statsErr).Msg("Database error fetching basic stats, stats will be omitted")
 		} else if dbUserStats != nil {
@@ -130,9 +134,12 @@ func (uph *UserProfileHandler) HandleGetUserProfile(w http.ResponseWriter, r *ht
 				winPercentage = math.Round((float64(dbUserStats.TotalWins)/float64(dbUserStats.TotalGamesPlayed))*10000) / 100
 			}
 			finalResponse.Stats = &apiModels.UserStats{
-				TotalGamesPlayed: dbUserStats.TotalGamesPlayed,
-				TotalWins:        dbUserStats.TotalWins,
-				WinPercentage:    winPercentage,
+				TotalGamesPlayed:   dbUserStats.TotalGamesPlayed,
+				TotalWins:          dbUserStats.TotalWins,
+				WinPercentage:      winPercentage,
+				BonusCounts:        dbUserStats.BonusCounts,
+				Ruleset:            statsFilter.Ruleset,
+				ExcludesAsterisked: statsFilter.ExcludeAsterisked,
 			}
 			logger.Debug().Interface("stats_data_for_api", finalResponse.Stats).Msg("Stats data prepared")
 		}
@@ -9,6 +9,7 @@ import (
 	"math"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/gorilla/sessions"
@@ -17,6 +18,7 @@ import (
 
 	a "github.com/seankim658/skullking/internal/auth"
 	db "github.com/seankim658/skullking/internal/database"
+	"github.com/seankim658/skullking/internal/games"
 	l "github.com/seankim658/skullking/internal/logger"
 	apiModels "github.com/seankim658/skullking/internal/models/api"
 	modelConverters "github.com/seankim658/skullking/internal/models/convert"
@@ -26,6 +28,7 @@ import (
 const utilComponent = "handlers-utils"
 const defaultPage = 1
 const defaultPageSize = 25
+const maxReasonLength = 255
 
 // Writes a JSON response with the given status code and data
 func Respond(w http.ResponseWriter, r *http.Request, status int, data any, message string) {
@@ -57,6 +60,39 @@ func ErrorResponse(w http.ResponseWriter, r *http.Request, status int, message s
 	Respond(w, r, status, nil, message)
 }
 
+// A change that cannot be applied to the current state of a game, with the status and message
+// to respond with. Changes failing validation list each invalid field.
+type actionError struct {
+	status      int
+	message     string
+	fieldErrors []apiModels.FieldError
+}
+
+func (e *actionError) Error() string {
+	return e.message
+}
+
+// Writes the response for an error from applying a change, the action error's own status and
+// message or a server error with the fallback message for any other error
+func respondActionError(w http.ResponseWriter, r *http.Request, err error, serverErrorMessage string) {
+	var actionErr *actionError
+	if errors.As(err, &actionErr) {
+		if len(actionErr.fieldErrors) > 0 {
+			FieldErrorResponse(w, r, actionErr.fieldErrors)
+		} else {
+			ErrorResponse(w, r, actionErr.status, actionErr.message)
+		}
+		return
+	}
+	ErrorResponse(w, r, http.StatusInternalServerError, serverErrorMessage)
+}
+
+// Writes a validation error response listing every invalid field so the frontend can
+// highlight each one
+func FieldErrorResponse(w http.ResponseWriter, r *http.Request, fieldErrors []apiModels.FieldError) {
+	Respond(w, r, http.StatusBadRequest, apiModels.ValidationErrorResponse{Errors: fieldErrors}, "Validation failed")
+}
+
 // Decodes the JSON request body
 func ParseJSON(w http.ResponseWriter, r *http.Request, dst any) bool {
 	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
@@ -77,6 +113,23 @@ func RequireFields(w http.ResponseWriter, r *http.Request, fields map[string]str
 	return true
 }
 
+// Trims an optional free text reason, treating a blank reason as no reason. Responds with an
+// error if the reason is too long.
+func OptionalReason(w http.ResponseWriter, r *http.Request, reason *string) (*string, bool) {
+	if reason == nil {
+		return nil, true
+	}
+	trimmed := strings.TrimSpace(*reason)
+	if len(trimmed) > maxReasonLength {
+		ErrorResponse(w, r, http.StatusBadRequest, fmt.Sprintf("reason must be at most %d characters", maxReasonLength))
+		return nil, false
+	}
+	if trimmed == "" {
+		return nil, true
+	}
+	return &trimmed, true
+}
+
 // Get a path var
 func PathVar(w http.ResponseWriter, r *http.Request, varName string) (string, bool) {
 	vars := mux.Vars(r)
@@ -169,6 +222,28 @@ func StartTx(ctx context.Context, w http.ResponseWriter, r *http.Request, logger
 	return tx, true
 }
 
+// Start a database transaction for changing scores, attributing every change recorded in the
+// score audit log to the acting user, or send the error response
+func StartScoringTx(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	logger zerolog.Logger,
+	actingUserID string,
+	errorMessage string,
+) (*sql.Tx, bool) {
+	tx, ok := StartTx(ctx, w, r, logger, errorMessage)
+	if !ok {
+		return nil, false
+	}
+	if err := db.SetActingUser(ctx, tx, actingUserID); err != nil {
+		_ = tx.Rollback()
+		ErrorResponse(w, r, http.StatusInternalServerError, errorMessage)
+		return nil, false
+	}
+	return tx, true
+}
+
 // Get the session cookie
 func GetSessionStore(w http.ResponseWriter, r *http.Request, failureMessage string, httpStatus int, logger zerolog.Logger) (*sessions.Session, error) {
 	session, err := gothic.Store.Get(r, a.SessionCookieName)
@@ -262,3 +337,63 @@ func CheckGameAccessAndScorekeeper(
 	logger.Debug().Str(l.GameIDKey, gameID).Str(l.UserIDKey, userID).Msg("User confirmed as scorekeeper")
 	return game, true
 }
+
+// Verifies a game exists and the authenticated user can view it, the scorekeeper, the creator
+// and registered players in the game always have access. Other users are let in by the game's
+// visibility, any user for public games and friends of a registered player for friends games.
+func CheckGameViewAccess(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	gameID string,
+	userID string,
+	logger zerolog.Logger,
+) (*dbModels.Game, bool) {
+	game, err := db.GetGameByID(ctx, nil, gameID)
+	if err != nil {
+		if errors.Is(err, db.ErrGameNotFound) {
+			logger.Warn().Err(err).Str(l.GameIDKey, gameID).Msg("Game not found for view access check")
+			ErrorResponse(w, r, http.StatusNotFound, "Game not found")
+		} else {
+			logger.Error().Err(err).Str(l.GameIDKey, gameID).Msg("Failed to fetch game for view access check")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to verify game access")
+		}
+		return nil, false
+	}
+
+	if game.CreatedByUserID == userID || (game.CurrentScorekeeperUserID.Valid && game.CurrentScorekeeperUserID.String == userID) {
+		return game, true
+	}
+
+	inGame, err := db.IsUserInGame(ctx, nil, gameID, userID)
+	if err != nil {
+		logger.Error().Err(err).Str(l.GameIDKey, gameID).Msg("Failed to check if user is a player in the game")
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to verify game access")
+		return nil, false
+	}
+	if inGame {
+		logger.Debug().Str(l.GameIDKey, gameID).Str(l.UserIDKey, userID).Msg("User confirmed as game participant")
+		return game, true
+	}
+
+	switch game.Visibility {
+	case games.GameVisibilityPublic:
+		logger.Debug().Str(l.GameIDKey, gameID).Str(l.UserIDKey, userID).Msg("Game is public, granting view access")
+		return game, true
+	case games.GameVisibilityFriends:
+		isFriend, err := db.IsFriendOfGamePlayer(ctx, nil, gameID, userID)
+		if err != nil {
+			logger.Error().Err(err).Str(l.GameIDKey, gameID).Msg("Failed to check if user is a friend of a player in the game")
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to verify game access")
+			return nil, false
+		}
+		if isFriend {
+			logger.Debug().Str(l.GameIDKey, gameID).Str(l.UserIDKey, userID).Msg("User confirmed as friend of a game player")
+			return game, true
+		}
+	}
+
+	logger.Warn().Str(l.GameIDKey, gameID).Str(l.UserIDKey, userID).Msg("User is not a player in the game")
+	ErrorResponse(w, r, http.StatusForbidden, "You are not authorized to view this game")
+	return nil, false
+}
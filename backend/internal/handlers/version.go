@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	db "github.com/seankim658/skullking/internal/database"
+	l "github.com/seankim658/skullking/internal/logger"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+// Header carrying the game's version on round responses, whose ETag is the round's. Every round
+// change also bumps its game's version, so clients echo this header in the `If-Match` of their
+// next game write.
+const gameETagHeader = "X-Game-ETag"
+
+var (
+	errPreconditionRequired = errors.New("if-match header is required")
+	errPreconditionFailed   = errors.New("resource version does not match if-match header")
+)
+
+// Formats a game or round version as an ETag
+func versionETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// Reports whether an `If-Match` header matches the version. Weak tags are compared as strong
+// ones since proxies compressing responses weaken the ETags we send.
+func ifMatchesVersion(header string, version int) bool {
+	etag := versionETag(version)
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// Reads the `If-Match` header of a write, responding with 428 if it is missing
+func requireIfMatch(w http.ResponseWriter, r *http.Request, logger zerolog.Logger) (string, error) {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" {
+		logger.Warn().Msg("Write request is missing the If-Match header")
+		ErrorResponse(w, r, http.StatusPreconditionRequired, "The If-Match header with the game or round version is required")
+		return "", errPreconditionRequired
+	}
+	return ifMatch, nil
+}
+
+// Locks a game for the rest of the transaction and checks the `If-Match` header against its
+// version. Responds with 428 if the header is missing and with 412 and the current game if the
+// game changed since the client fetched it, returning an error for the caller to roll back.
+func checkGameVersion(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	tx *sql.Tx,
+	gameID string,
+	logger zerolog.Logger,
+) error {
+	ifMatch, err := requireIfMatch(w, r, logger)
+	if err != nil {
+		return err
+	}
+
+	version, err := db.LockGameVersion(ctx, tx, gameID)
+	if err != nil {
+		if errors.Is(err, db.ErrGameNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Game not found")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to verify game version")
+		}
+		return err
+	}
+	if !ifMatchesVersion(ifMatch, version) {
+		logger.Warn().Str(l.IfMatchKey, ifMatch).Int(l.VersionKey, version).Msg("Game changed since the client fetched it")
+		respondWithGame(w, r, gameID, http.StatusPreconditionFailed, "The game has changed since it was last fetched", logger)
+		return errPreconditionFailed
+	}
+	return nil
+}
+
+// Sets the ETag of the response to a game write that responds with something other than the
+// game, so the client can make its next write without fetching the game again
+func setGameETag(ctx context.Context, w http.ResponseWriter, gameID string, logger zerolog.Logger) {
+	setGameVersionHeader(ctx, w, "ETag", gameID, logger)
+}
+
+// Sets a response header to the game's current version, formatted as an ETag
+func setGameVersionHeader(ctx context.Context, w http.ResponseWriter, header, gameID string, logger zerolog.Logger) {
+	game, err := db.GetGameByID(ctx, nil, gameID)
+	if err != nil {
+		logger.Error().Err(err).Str("header", header).Msg("Failed to fetch game version for response, omitting it")
+		return
+	}
+	w.Header().Set(header, versionETag(game.Version))
+}
+
+// Locks a round for the rest of the transaction and checks the `If-Match` header against its
+// version. The round's game is locked first, in the same order as game writes, so concurrent
+// game and round writes queue instead of deadlocking. Responds with 428 if the header is missing
+// and with 412 and the current round if it changed since the client fetched it, returning an
+// error for the caller to roll back.
+func checkRoundVersion(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *http.Request,
+	tx *sql.Tx,
+	round *dbModels.Round,
+	logger zerolog.Logger,
+) error {
+	ifMatch, err := requireIfMatch(w, r, logger)
+	if err != nil {
+		return err
+	}
+
+	if _, err := db.LockGameVersion(ctx, tx, round.GameID); err != nil {
+		ErrorResponse(w, r, http.StatusInternalServerError, "Failed to verify round version")
+		return err
+	}
+	version, err := db.LockRoundVersion(ctx, tx, round.RoundID)
+	if err != nil {
+		if errors.Is(err, db.ErrRoundNotFound) {
+			ErrorResponse(w, r, http.StatusNotFound, "Round not found")
+		} else {
+			ErrorResponse(w, r, http.StatusInternalServerError, "Failed to verify round version")
+		}
+		return err
+	}
+	if !ifMatchesVersion(ifMatch, version) {
+		logger.Warn().Str(l.IfMatchKey, ifMatch).Int(l.VersionKey, version).Msg("Round changed since the client fetched it")
+		respondWithRound(w, r, round.RoundID, http.StatusPreconditionFailed, "The round has changed since it was last fetched", logger)
+		return errPreconditionFailed
+	}
+	return nil
+}
@@ -24,6 +24,12 @@ const (
 	HostnameKey          = "hostname"
 	IPKey                = "ip"
 
+	// Idempotency-Key errors
+	RequestTooLargeError          = "request-too-large"
+	InvalidIdempotencyKeyError    = "invalid-idempotency-key"
+	IdempotencyKeyReusedError     = "idempotency-key-reused"
+	IdempotencyKeyInProgressError = "idempotency-key-in-progress"
+
 	// --- Database Logging Fields ---
 	QueryKey                = "query"
 	SearchQueryKey          = "search_query"
@@ -68,7 +74,50 @@ const (
 	GuestPlayerNameKey = "guest_player_display_name"
 
 	// Game player
-	GamePlayerIDKey = "game_player_id"
-	SeatingOrderKey = "seating_order"
-	GameStatusKey   = "game_status"
+	GamePlayerIDKey      = "game_player_id"
+	SeatingOrderKey      = "seating_order"
+	GameStatusKey        = "game_status"
+	FinalScoreKey        = "final_score"
+	FinishingPositionKey = "finishing_position"
+
+	// Round
+	RoundIDKey     = "round_id"
+	RoundNumberKey = "round_number"
+	CardsDealtKey  = "cards_dealt"
+	BidAmountKey   = "bid_amount"
+	TricksTakenKey = "tricks_taken"
+	RoundScoreKey  = "round_score"
+
+	// Round bonus event
+	BonusEventIDKey = "bonus_event_id"
+	BonusTypeKey    = "bonus_type"
+
+	// Score audit log
+	AuditIDKey = "audit_id"
+
+	// Player game asterisk
+	AsteriskIDKey = "player_game_asterisk_id"
+
+	// Scorekeeper handoff
+	HandoffIDKey = "handoff_id"
+
+	// Game events
+	EventTypeKey = "event_type"
+
+	// Optimistic concurrency
+	VersionKey = "version"
+	IfMatchKey = "if_match"
+
+	// Batch sync
+	ClientActionIDKey = "client_action_id"
+	ActionTypeKey     = "action_type"
+
+	// Idempotent requests
+	IdempotencyKeyKey = "idempotency_key"
+
+	// Join codes
+	JoinCodeKey = "join_code"
+
+	// Share links
+	ShareLinkIDKey = "share_link_id"
 )
@@ -32,12 +32,24 @@ func CorsMiddleware(cfg *cf.Config) func(http.Handler) http.Handler {
 		"Authorization",
 		"X-Requested-With",
 		"X-CRSF-Token",
+		"If-Match",
+		"Idempotency-Key",
+	}
+
+	// Game and round versions are sent as ETags for clients to echo back in If-Match, round
+	// responses carry their game's version too, replayed responses to retried writes are marked
+	// so clients can tell them apart
+	exposedHeaders := []string{
+		"ETag",
+		"X-Game-ETag",
+		"Idempotent-Replayed",
 	}
 
 	corsMiddleware := handlers.CORS(
 		handlers.AllowedOrigins(allowedOrigins),
 		handlers.AllowedMethods(allowedMethods),
 		handlers.AllowedHeaders(allowedHeaders),
+		handlers.ExposedHeaders(exposedHeaders),
 		handlers.AllowCredentials(),
 	)
 
@@ -0,0 +1,193 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	a "github.com/seankim658/skullking/internal/auth"
+	l "github.com/seankim658/skullking/internal/logger"
+)
+
+// Header clients send to make retries of a write safe, and the header marking replayed responses
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+const (
+	maxIdempotencyKeyLength   = 255
+	maxIdempotentRequestBytes = 1 << 20 // Largest request body stored for comparing retries
+)
+
+// The first response to a request made with an idempotency key, replayed for its retries
+type idempotentResponse struct {
+	fingerprint [sha256.Size]byte
+	status      int
+	header      http.Header
+	body        []byte
+	completed   bool
+	expiresAt   time.Time
+}
+
+var (
+	idempotentResponses = make(map[string]*idempotentResponse) // Map of responses by user and key
+	idempotencyMu       sync.Mutex
+)
+
+// Periodically removes expired responses so the `idempotentResponses` map doesn't grow
+// indefinitely
+func init() {
+	go func() {
+		for {
+			time.Sleep(10 * time.Minute) // Interval for cleanup check
+
+			idempotencyMu.Lock()
+			now := time.Now()
+			for key, resp := range idempotentResponses {
+				if resp.completed && now.After(resp.expiresAt) {
+					delete(idempotentResponses, key)
+				}
+			}
+			idempotencyMu.Unlock()
+		}
+	}()
+}
+
+// Records the response of a handler while writing it to the client
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rw *recordingResponseWriter) WriteHeader(code int) {
+	if rw.status == 0 {
+		rw.status = code
+	}
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *recordingResponseWriter) Write(b []byte) (int, error) {
+	if rw.status == 0 {
+		rw.status = http.StatusOK
+	}
+	rw.body.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
+
+func (rw *recordingResponseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+// Honors the `Idempotency-Key` header on writes by authenticated users. The first response to a
+// key is stored and replayed for retries with the same key within the TTL, a key reused with a
+// different request is rejected. Keys are scoped to the user, requests without a key or a
+// signed in user pass through untouched. Server errors aren't stored so the retry runs again,
+// neither are conflicts and failed or missing `If-Match` preconditions, the client resolves
+// those and retries with the same key.
+func Idempotency(ttl time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+			logger := l.GetLoggerFromContext(r.Context()).With().Str(l.IdempotencyKeyKey, key).Logger()
+
+			userID := a.SessionUserID(r)
+			if userID == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			logger = logger.With().Str(l.UserIDKey, userID).Logger()
+
+			if len(key) > maxIdempotencyKeyLength {
+				http.Error(w, l.InvalidIdempotencyKeyError, http.StatusBadRequest)
+				return
+			}
+
+			// The method, path and query are part of the fingerprint so a key can't be reused on another
+			// endpoint
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentRequestBytes+1))
+			if err != nil {
+				logger.Warn().Err(err).Msg("Failed to read request body for idempotency check")
+				http.Error(w, l.InvalidIdempotencyKeyError, http.StatusBadRequest)
+				return
+			}
+			if len(body) > maxIdempotentRequestBytes {
+				http.Error(w, l.RequestTooLargeError, http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			hash := sha256.New()
+			hash.Write([]byte(r.Method + " " + r.URL.Path + "?" + r.URL.RawQuery + "\n"))
+			hash.Write(body)
+			var fingerprint [sha256.Size]byte
+			copy(fingerprint[:], hash.Sum(nil))
+
+			storeKey := userID + ":" + key
+			idempotencyMu.Lock()
+			stored, found := idempotentResponses[storeKey]
+			if found && stored.completed && time.Now().After(stored.expiresAt) {
+				delete(idempotentResponses, storeKey)
+				found = false
+			}
+			if found {
+				idempotencyMu.Unlock()
+				switch {
+				case stored.fingerprint != fingerprint:
+					logger.Warn().Msg("Idempotency key reused with a different request")
+					http.Error(w, l.IdempotencyKeyReusedError, http.StatusUnprocessableEntity)
+				case !stored.completed:
+					logger.Warn().Msg("Retry arrived while the original request is still in progress")
+					http.Error(w, l.IdempotencyKeyInProgressError, http.StatusConflict)
+				default:
+					logger.Info().Int(l.StatusCodeKey, stored.status).Msg("Replaying stored response for idempotency key")
+					for name, values := range stored.header {
+						w.Header()[name] = values
+					}
+					w.Header().Set(IdempotentReplayedHeader, "true")
+					w.WriteHeader(stored.status)
+					_, _ = w.Write(stored.body)
+				}
+				return
+			}
+			pending := &idempotentResponse{fingerprint: fingerprint}
+			idempotentResponses[storeKey] = pending
+			idempotencyMu.Unlock()
+
+			rw := &recordingResponseWriter{ResponseWriter: w}
+			defer func() {
+				idempotencyMu.Lock()
+				defer idempotencyMu.Unlock()
+				if !isStoredIdempotentStatus(rw.status) {
+					delete(idempotentResponses, storeKey)
+					return
+				}
+				pending.status = rw.status
+				pending.header = rw.Header().Clone()
+				pending.body = rw.body.Bytes()
+				pending.completed = true
+				pending.expiresAt = time.Now().Add(ttl)
+			}()
+
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
+
+// Reports whether a response is final for its idempotency key. Server errors, conflicts and
+// `If-Match` precondition failures depend on state that can change before the retry.
+func isStoredIdempotentStatus(status int) bool {
+	switch status {
+	case 0, http.StatusConflict, http.StatusPreconditionFailed, http.StatusPreconditionRequired:
+		return false
+	default:
+		return status < http.StatusInternalServerError
+	}
+}
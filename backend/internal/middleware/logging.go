@@ -50,3 +50,9 @@ func LoggingMiddleware(accessLog zerolog.Logger, appLog zerolog.Logger) func(htt
 		})
 	}
 }
+
+// Exposes the wrapped writer so `http.ResponseController` can reach optional interfaces
+// such as `http.Flusher`, which streaming handlers rely on
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
@@ -14,3 +14,16 @@ type Pagination struct {
 	TotalPages  int64 `json:"total_pages"`
 	TotalCount  int64 `json:"total_count"`
 }
+
+// A validation failure for a single request field, GamePlayerID identifies the player the
+// field belongs to when the request holds an entry per player
+type FieldError struct {
+	Field        string `json:"field"`
+	GamePlayerID string `json:"game_player_id,omitempty"`
+	Message      string `json:"message"`
+}
+
+// Response data for a request that failed validation
+type ValidationErrorResponse struct {
+	Errors []FieldError `json:"errors"`
+}
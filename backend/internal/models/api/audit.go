@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// A single field change in a game's score history
+type ScoreAuditEntryResponse struct {
+	AuditID string `json:"audit_id"`
+	RoundID string `json:"round_id"`
+	// Omitted once the round has been removed
+	RoundNumber *int `json:"round_number,omitempty"`
+	// Omitted for changes to the round itself
+	GamePlayerID         *string   `json:"game_player_id,omitempty"`
+	TableName            string    `json:"table_name"`
+	Operation            string    `json:"operation"`
+	FieldName            string    `json:"field_name"`
+	OldValue             *string   `json:"old_value,omitempty"`
+	NewValue             *string   `json:"new_value,omitempty"`
+	ChangedByUserID      *string   `json:"changed_by_user_id,omitempty"`
+	ChangedByDisplayName string    `json:"changed_by_display_name,omitempty"`
+	ChangedAt            time.Time `json:"changed_at"`
+	// Whether the old value can be restored with the restore endpoint
+	Restorable bool `json:"restorable"`
+}
+
+type GameHistoryResponse struct {
+	GameID  string                    `json:"game_id"`
+	Entries []ScoreAuditEntryResponse `json:"entries"`
+}
+
+// A player's total score and position at a point in a game's timeline
+type TimelineStandingResponse struct {
+	GamePlayerID string `json:"game_player_id"`
+	Score        int    `json:"score"`
+	Position     int    `json:"position"`
+}
+
+// A player's bid, tricks taken or corrected value in a timeline event
+type TimelineEntryValueResponse struct {
+	GamePlayerID string `json:"game_player_id"`
+	Value        int    `json:"value"`
+}
+
+// Something that happened in a game with the standings once it happened
+type TimelineEventResponse struct {
+	// `player_added`, `game_started`, `round_started`, `bids_submitted`, `round_completed`,
+	// `bonus_recorded`, `score_corrected`, `round_undone`, `game_completed` or `game_abandoned`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	// Omitted for events that aren't about a round and once the round has been removed
+	RoundNumber   *int     `json:"round_number,omitempty"`
+	GamePlayerIDs []string `json:"game_player_ids,omitempty"`
+	BonusType     *string  `json:"bonus_type,omitempty"`
+	// `bid_amount` or `tricks_taken`, for bids, results and corrections
+	Field     *string                      `json:"field,omitempty"`
+	Values    []TimelineEntryValueResponse `json:"values,omitempty"`
+	Standings []TimelineStandingResponse   `json:"standings"`
+}
+
+type GameTimelineResponse struct {
+	GameID  string                  `json:"game_id"`
+	Players []GamePlayerResponse    `json:"players"`
+	Events  []TimelineEventResponse `json:"events"`
+}
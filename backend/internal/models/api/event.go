@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// Types of changes streamed to clients watching a game
+const (
+	GameEventStatusChanged      = "game_status_changed"
+	GameEventPlayersUpdated     = "players_updated"
+	GameEventScorekeeperChanged = "scorekeeper_changed"
+	GameEventRoundCreated       = "round_created"
+	GameEventBidsSubmitted      = "bids_submitted"
+	GameEventTricksSubmitted    = "tricks_submitted"
+	// Scores of a round changed outside the normal flow, e.g. a correction, bonus or undo
+	GameEventRoundUpdated = "round_updated"
+	// A player submitted their own bid, the bid itself stays hidden until the bids are revealed
+	GameEventBidPlaced    = "bid_placed"
+	GameEventBidsRevealed = "bids_revealed"
+)
+
+// A committed change to a game, streamed to clients watching it. Events only identify what
+// changed, clients fetch the game or round to get the new state.
+type GameEvent struct {
+	Type        string  `json:"type"`
+	GameID      string  `json:"game_id"`
+	RoundID     *string `json:"round_id,omitempty"`
+	RoundNumber *int    `json:"round_number,omitempty"`
+	// The new game status for status changes and the round's status for round events
+	Status     string    `json:"status,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
@@ -6,6 +6,31 @@ import "time"
 type CreateGameRequest struct {
 	SessionID   *string `json:"session_id,omitempty"`
 	SessionName *string `json:"session_name,omitempty"`
+	// House rule for a tie for first place, `shared_victory` (default) or `tiebreaker_round`
+	TiebreakerRule *string `json:"tiebreaker_rule,omitempty"`
+	// Rules the game is played with, defaults to classic scoring without expansions
+	Ruleset *GameRuleset `json:"ruleset,omitempty"`
+	// Round structure from the rulebook, defaults to `standard` (rounds 1 to 10)
+	RoundSchedule *string `json:"round_schedule,omitempty"`
+	// Who besides the participants can view the game, `participants` (default), `friends` or `public`
+	Visibility *string `json:"visibility,omitempty"`
+}
+
+// The scoring system and expansion cards a game is played with
+type GameRuleset struct {
+	// Scoring system, `classic` or `rascal`
+	Name       string `json:"name" validate:"required"`
+	Kraken     bool   `json:"kraken"`
+	WhiteWhale bool   `json:"white_whale"`
+	Loot       bool   `json:"loot"`
+}
+
+// The sequence of rounds a game is played over
+type GameRoundSchedule struct {
+	// `standard`, `even_keeled`, `skip_to_the_brawl`, `swift_n_salty` or `broadside_barrage`
+	Name string `json:"name"`
+	// Cards dealt to each player per round, the first entry is round 1
+	CardsPerRound []int `json:"cards_per_round"`
 }
 
 // Request to add a player to a game
@@ -15,21 +40,134 @@ type AddPlayerToGameRequest struct {
 	SeatingOrder int     `json:"seating_order" validate:"required,gt=0"`
 }
 
+// Request to replace the registered user or guest in a player's seat
+type ReplacePlayerRequest struct {
+	UserID    *string `json:"user_id,omitempty"`
+	GuestName *string `json:"guest_name,omitempty"`
+}
+
+// A single player's seat in a seating arrangement
+type PlayerSeat struct {
+	GamePlayerID string `json:"game_player_id" validate:"required"`
+	SeatingOrder int    `json:"seating_order" validate:"required,gt=0"`
+}
+
+// Request to rewrite the seating order of every player in a pending game
+type ReorderPlayersRequest struct {
+	Seats []PlayerSeat `json:"seats" validate:"required"`
+}
+
+// Request to start a pending game
+type StartGameRequest struct {
+	RandomizeSeating           *bool   `json:"randomize_seating,omitempty"`
+	StartingDealerGamePlayerID *string `json:"starting_dealer_game_player_id,omitempty"`
+}
+
 // Response for a created game
 type GameResponse struct {
-	GameID          string    `json:"game_id"`
-	SessionID       *string   `json:"session_id,omitempty"`
-	Status          string    `json:"status"`
-	CreatedAt       time.Time `json:"created_at"`
-	CreatedByUserID string    `json:"created_by_user_id"`
+	GameID                       string               `json:"game_id"`
+	SessionID                    *string              `json:"session_id,omitempty"`
+	Status                       string               `json:"status"`
+	CreatedAt                    time.Time            `json:"created_at"`
+	CreatedByUserID              string               `json:"created_by_user_id"`
+	StartingDealerGamePlayerID   *string              `json:"starting_dealer_game_player_id,omitempty"`
+	PlayerSeatingOrderRandomized bool                 `json:"player_seating_order_randomized"`
+	TiebreakerRule               string               `json:"tiebreaker_rule"`
+	Ruleset                      GameRuleset          `json:"ruleset"`
+	RoundSchedule                GameRoundSchedule    `json:"round_schedule"`
+	Visibility                   string               `json:"visibility"`
+	Version                      int                  `json:"version"`
+	StartedAt                    *time.Time           `json:"started_at,omitempty"`
+	CompletedAt                  *time.Time           `json:"completed_at,omitempty"`
+	AbandonedAt                  *time.Time           `json:"abandoned_at,omitempty"`
+	AbandonReason                *string              `json:"abandon_reason,omitempty"`
+	Players                      []GamePlayerResponse `json:"players,omitempty"`
+}
+
+// A game with every round played so far and the running scoreboard
+type GameDetailResponse struct {
+	GameResponse
+	Rounds     []RoundResponse      `json:"rounds"`
+	Scoreboard []ScoreboardResponse `json:"scoreboard"`
+}
+
+// A player's row of the scoreboard. Tiebreaker rounds are not included as they never count
+// towards the total score.
+type ScoreboardResponse struct {
+	GamePlayerID string                 `json:"game_player_id"`
+	Rounds       []RunningScoreResponse `json:"rounds"`
+	Total        int                    `json:"total"`
+}
+
+// A player's score for a completed round and their total after it
+type RunningScoreResponse struct {
+	RoundNumber  int `json:"round_number"`
+	RoundScore   int `json:"round_score"`
+	RunningTotal int `json:"running_total"`
+}
+
+// Response when a game cannot be completed until a tiebreaker round is played
+type TiebreakerRequiredResponse struct {
+	TiedGamePlayerIDs []string `json:"tied_game_player_ids"`
 }
 
 type GamePlayerResponse struct {
-	GamePlayerID  string  `json:"game_player_id"`
-	GameID        string  `json:"game_id"`
-	UserID        *string `json:"user_id,omitempty"`
-	GuestPlayerID *string `json:"guest_player_id,omitempty"`
-	DisplayName   string  `json:"display_name"`
-	SeatingOrder  int     `json:"seating_order"`
-	FinalScore    int     `json:"final_score"`
+	GamePlayerID      string     `json:"game_player_id"`
+	GameID            string     `json:"game_id"`
+	UserID            *string    `json:"user_id,omitempty"`
+	GuestPlayerID     *string    `json:"guest_player_id,omitempty"`
+	DisplayName       string     `json:"display_name"`
+	SeatingOrder      int        `json:"seating_order"`
+	FinalScore        int        `json:"final_score"`
+	FinishingPosition *int       `json:"finishing_position,omitempty"`
+	LeftAt            *time.Time `json:"left_at,omitempty"`
+	JoinedAt          *time.Time `json:"joined_at,omitempty"`
+	JoinedWithCode    bool       `json:"joined_with_code"`
+}
+
+// A code players can enter, or scan as a QR code of the join URL, to add themselves to a
+// pending game
+type JoinCodeResponse struct {
+	GameID    string    `json:"game_id"`
+	Code      string    `json:"code"`
+	JoinURL   string    `json:"join_url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Scoring actions that can be undone
+const (
+	UndoActionRoundCreated    = "round_created"
+	UndoActionBidSubmitted    = "bid_submitted" // A single bid a player submitted themselves
+	UndoActionBidsSubmitted   = "bids_submitted"
+	UndoActionTricksSubmitted = "tricks_submitted"
+)
+
+// Response describing the scoring action an undo reverted
+type UndoResponse struct {
+	UndoneAction string `json:"undone_action"`
+	RoundID      string `json:"round_id"`
+	RoundNumber  int    `json:"round_number"`
+	// The round's status after the undo, omitted when the round was removed
+	RoundStatus *string `json:"round_status,omitempty"`
+	// The player whose own bid was removed, only set for a single undone bid
+	GamePlayerID *string `json:"game_player_id,omitempty"`
+}
+
+// Request to mark a player's game with an asterisk
+type CreateAsteriskRequest struct {
+	GamePlayerID string  `json:"game_player_id" validate:"required"`
+	Reason       *string `json:"reason,omitempty" validate:"omitempty,max=255"`
+}
+
+type AsteriskResponse struct {
+	AsteriskID   string    `json:"asterisk_id"`
+	GameID       string    `json:"game_id"`
+	GamePlayerID string    `json:"game_player_id"`
+	Reason       *string   `json:"reason,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Request to abandon a game or session, the reason is optional
+type AbandonRequest struct {
+	Reason *string `json:"reason,omitempty" validate:"omitempty,max=255"`
 }
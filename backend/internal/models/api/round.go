@@ -0,0 +1,91 @@
+package models
+
+import "time"
+
+// A single player's bid for a round
+type PlayerBid struct {
+	GamePlayerID string `json:"game_player_id" validate:"required"`
+	BidAmount    int    `json:"bid_amount" validate:"gte=0"`
+}
+
+// Request to submit the bids for a round
+type SubmitBidsRequest struct {
+	Bids []PlayerBid `json:"bids" validate:"required"`
+}
+
+// A single player's result for a round
+type PlayerTricks struct {
+	GamePlayerID string `json:"game_player_id" validate:"required"`
+	TricksTaken  int    `json:"tricks_taken" validate:"gte=0"`
+}
+
+// Request for a player to submit their own bid for a round
+type SubmitOwnBidRequest struct {
+	BidAmount int `json:"bid_amount" validate:"gte=0"`
+}
+
+// Request to submit the tricks taken for a round
+type SubmitTricksRequest struct {
+	Tricks []PlayerTricks `json:"tricks" validate:"required"`
+	// Tricks discarded by the Kraken or the White Whale and taken by nobody, only allowed when
+	// either card is in play
+	KrakenDiscardedTricks int `json:"kraken_discarded_tricks" validate:"gte=0"`
+}
+
+// A correction to a player's bid or tricks taken, fields left out keep their current value
+type ScoreCorrection struct {
+	GamePlayerID string `json:"game_player_id" validate:"required"`
+	BidAmount    *int   `json:"bid_amount,omitempty"`
+	TricksTaken  *int   `json:"tricks_taken,omitempty"`
+}
+
+// Request to correct the bids or tricks taken of a round after they were submitted
+type CorrectScoresRequest struct {
+	Corrections []ScoreCorrection `json:"corrections" validate:"required"`
+	// Tricks discarded by the Kraken or the White Whale, the round's current value is kept when
+	// left out
+	KrakenDiscardedTricks *int `json:"kraken_discarded_tricks,omitempty"`
+}
+
+// Request to record a bonus captured during a round
+type RecordBonusEventRequest struct {
+	// The player who captured the bonus
+	GamePlayerID string `json:"game_player_id" validate:"required"`
+	BonusType    string `json:"bonus_type" validate:"required"`
+	// The player who played the Loot card, required for a `loot_alliance` only
+	AllyGamePlayerID *string `json:"ally_game_player_id,omitempty"`
+}
+
+type BonusEventResponse struct {
+	BonusEventID     string    `json:"bonus_event_id"`
+	GamePlayerID     string    `json:"game_player_id"`
+	BonusType        string    `json:"bonus_type"`
+	AllyGamePlayerID *string   `json:"ally_game_player_id,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type PlayerRoundScoreResponse struct {
+	GamePlayerID       string `json:"game_player_id"`
+	BidAmount          *int   `json:"bid_amount"` // Null while the bid is hidden from the viewer
+	TricksTaken        *int   `json:"tricks_taken,omitempty"`
+	RoundScore         int    `json:"round_score"`
+	BonusPointsApplied int    `json:"bonus_points_applied"`
+}
+
+type RoundResponse struct {
+	RoundID                string                     `json:"round_id"`
+	GameID                 string                     `json:"game_id"`
+	RoundNumber            int                        `json:"round_number"`
+	CardsDealt             int                        `json:"cards_dealt"`
+	DealerGamePlayerID     string                     `json:"dealer_game_player_id"`
+	NextBidderGamePlayerID *string                    `json:"next_bidder_game_player_id,omitempty"`
+	Status                 string                     `json:"status"`
+	IsTiebreakerRound      bool                       `json:"is_tiebreaker_round"`
+	KrakenDiscardedTricks  int                        `json:"kraken_discarded_tricks"`
+	BidsHidden             bool                       `json:"bids_hidden"` // Other players' bids are hidden until revealed
+	Version                int                        `json:"version"`
+	Scores                 []PlayerRoundScoreResponse `json:"scores"`
+	BonusEvents            []BonusEventResponse       `json:"bonus_events"`
+	CreatedAt              time.Time                  `json:"created_at"`
+	UpdatedAt              time.Time                  `json:"updated_at"`
+}
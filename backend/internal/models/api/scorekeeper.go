@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// Request for the current scorekeeper to hand scorekeeping to another registered player
+type TransferScorekeeperRequest struct {
+	ToUserID string `json:"to_user_id" validate:"required"`
+}
+
+type ScorekeeperHandoffResponse struct {
+	HandoffID   string     `json:"handoff_id"`
+	GameID      string     `json:"game_id"`
+	FromUserID  *string    `json:"from_user_id,omitempty"`
+	ToUserID    *string    `json:"to_user_id,omitempty"`
+	HandoffType string     `json:"handoff_type"`
+	Status      string     `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ResolvedAt  *time.Time `json:"resolved_at,omitempty"`
+}
@@ -11,3 +11,12 @@ type ActiveSessionResponse struct {
 	UpdatedAt     time.Time  `json:"updated_at"`
 	CompletedAt   *time.Time `json:"completed_at,omitempty"`
 }
+
+// Response for an abandoned session
+type AbandonSessionResponse struct {
+	SessionID     string  `json:"session_id"`
+	Status        string  `json:"status"`
+	AbandonReason *string `json:"abandon_reason,omitempty"`
+	// The pending and active games abandoned along with the session
+	AbandonedGameIDs []string `json:"abandoned_game_ids"`
+}
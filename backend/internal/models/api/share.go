@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// A read-only link spectators without an account can use to follow a game, or every game in
+// a session
+type ShareLinkResponse struct {
+	ShareLinkID string    `json:"share_link_id"`
+	GameID      *string   `json:"game_id,omitempty"`
+	SessionID   *string   `json:"session_id,omitempty"`
+	Token       string    `json:"token"`
+	ShareURL    string    `json:"share_url"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// A game spectators can follow with a share link
+type SharedGameSummary struct {
+	GameID      string     `json:"game_id"`
+	Status      string     `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// What a share link gives spectators access to, a single game or the games of a session
+type SharedViewResponse struct {
+	GameID      *string             `json:"game_id,omitempty"`
+	SessionID   *string             `json:"session_id,omitempty"`
+	SessionName *string             `json:"session_name,omitempty"`
+	Games       []SharedGameSummary `json:"games"`
+}
@@ -4,6 +4,12 @@ type UserStats struct {
 	TotalGamesPlayed int     `json:"total_games_played"`
 	TotalWins        int     `json:"total_wins"`
 	WinPercentage    float64 `json:"win_percentage"`
+	// Bonuses captured in completed games keyed by bonus type, e.g. `skull_king_captured_by_mermaid`
+	BonusCounts map[string]int `json:"bonus_counts,omitempty"`
+	// The ruleset the stats are filtered to, omitted when every ruleset is counted
+	Ruleset string `json:"ruleset,omitempty"`
+	// Whether games with an asterisk on any player were left out
+	ExcludesAsterisked bool `json:"excludes_asterisked"`
 }
 
 type SiteSummaryStatsResponse struct {
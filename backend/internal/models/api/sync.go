@@ -0,0 +1,54 @@
+package models
+
+import "encoding/json"
+
+// Types of actions a client can queue while offline and apply with a batch sync
+const (
+	SyncActionAddPlayer    = "add_player"
+	SyncActionStartRound   = "start_round"
+	SyncActionSubmitBids   = "submit_bids"
+	SyncActionSubmitTricks = "submit_tricks"
+)
+
+// A single action queued by a client, identified by a UUID the client generates so the action
+// is applied once no matter how many times the batch is retried
+type SyncAction struct {
+	ClientActionID string `json:"client_action_id" validate:"required,uuid"`
+	// `add_player`, `start_round`, `submit_bids` or `submit_tricks`
+	Type string `json:"type" validate:"required"`
+	// Round the bids or tricks are for. For `start_round`, the number the client expects the new
+	// round to have, the action is rejected if another round would be started instead.
+	RoundNumber *int `json:"round_number,omitempty"`
+	// Body of the matching single action request, an `AddPlayerToGameRequest`,
+	// `SyncStartRoundPayload`, `SubmitBidsRequest` or `SubmitTricksRequest`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// Payload of a `start_round` action, left out to start the next regular round
+type SyncStartRoundPayload struct {
+	IsTiebreaker bool `json:"is_tiebreaker"`
+}
+
+// Request to apply a batch of actions queued by a client, in order
+type SyncRequest struct {
+	Actions []SyncAction `json:"actions" validate:"required"`
+}
+
+// Outcome of a single action in a batch sync
+type SyncActionResult struct {
+	ClientActionID string `json:"client_action_id"`
+	Type           string `json:"type"`
+	// `applied` or `rejected`
+	Status      string       `json:"status"`
+	Reason      *string      `json:"reason,omitempty"`
+	FieldErrors []FieldError `json:"field_errors,omitempty"`
+	// The action was synced before, by an earlier batch or earlier in this one, and was skipped.
+	// Status and reason are from when it was first synced.
+	Duplicate bool `json:"duplicate"`
+}
+
+// Response data for a batch sync, the outcome of every action and the game after the batch
+type SyncResponse struct {
+	Results []SyncActionResult `json:"results"`
+	Game    GameDetailResponse `json:"game"`
+}
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/seankim658/skullking/internal/games"
+	apiModels "github.com/seankim658/skullking/internal/models/api"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+func DBGameToAPIGame(dbGame *dbModels.Game) (*apiModels.GameResponse, error) {
+	if dbGame == nil {
+		return nil, errors.New("cannot convert nil db game to api game")
+	}
+
+	apiGame := &apiModels.GameResponse{
+		GameID:                       dbGame.GameID,
+		Status:                       dbGame.Status,
+		CreatedAt:                    dbGame.CreatedAt,
+		CreatedByUserID:              dbGame.CreatedByUserID,
+		PlayerSeatingOrderRandomized: dbGame.PlayerSeatingOrderRandomized,
+		TiebreakerRule:               dbGame.TiebreakerRule,
+		Visibility:                   dbGame.Visibility,
+		Version:                      dbGame.Version,
+	}
+
+	apiGame.Ruleset = apiModels.GameRuleset{
+		Name:       dbGame.Ruleset,
+		Kraken:     dbGame.KrakenEnabled,
+		WhiteWhale: dbGame.WhiteWhaleEnabled,
+		Loot:       dbGame.LootEnabled,
+	}
+
+	cardsPerRound, err := games.CardsPerRound(dbGame.RoundSchedule)
+	if err != nil {
+		return nil, err
+	}
+	apiGame.RoundSchedule = apiModels.GameRoundSchedule{
+		Name:          dbGame.RoundSchedule,
+		CardsPerRound: cardsPerRound,
+	}
+	if dbGame.SessionID.Valid {
+		apiGame.SessionID = &dbGame.SessionID.String
+	}
+	if dbGame.StartingDealerGamePlayerID.Valid {
+		apiGame.StartingDealerGamePlayerID = &dbGame.StartingDealerGamePlayerID.String
+	}
+	if dbGame.StartedAt.Valid {
+		apiGame.StartedAt = &dbGame.StartedAt.Time
+	}
+	if dbGame.CompletedAt.Valid {
+		apiGame.CompletedAt = &dbGame.CompletedAt.Time
+	}
+	if dbGame.AbandonedAt.Valid {
+		apiGame.AbandonedAt = &dbGame.AbandonedAt.Time
+	}
+	if dbGame.AbandonReason.Valid {
+		apiGame.AbandonReason = &dbGame.AbandonReason.String
+	}
+	return apiGame, nil
+}
+
+func DBAsteriskToAPIAsterisk(dbAsterisk *dbModels.PlayerGameAsterisk) (*apiModels.AsteriskResponse, error) {
+	if dbAsterisk == nil {
+		return nil, errors.New("cannot convert nil db asterisk to api asterisk")
+	}
+
+	apiAsterisk := &apiModels.AsteriskResponse{
+		AsteriskID:   dbAsterisk.PlayerGameAsteriskID,
+		GameID:       dbAsterisk.GameID,
+		GamePlayerID: dbAsterisk.GamePlayerID,
+		CreatedAt:    dbAsterisk.CreatedAt,
+	}
+	if dbAsterisk.Reason.Valid {
+		apiAsterisk.Reason = &dbAsterisk.Reason.String
+	}
+	return apiAsterisk, nil
+}
+
+func DBHandoffToAPIHandoff(dbHandoff *dbModels.ScorekeeperHandoff) (*apiModels.ScorekeeperHandoffResponse, error) {
+	if dbHandoff == nil {
+		return nil, errors.New("cannot convert nil db scorekeeper handoff to api scorekeeper handoff")
+	}
+
+	apiHandoff := &apiModels.ScorekeeperHandoffResponse{
+		HandoffID:   dbHandoff.HandoffID,
+		GameID:      dbHandoff.GameID,
+		HandoffType: dbHandoff.HandoffType,
+		Status:      dbHandoff.Status,
+		CreatedAt:   dbHandoff.CreatedAt,
+	}
+	if dbHandoff.FromUserID.Valid {
+		apiHandoff.FromUserID = &dbHandoff.FromUserID.String
+	}
+	if dbHandoff.ToUserID.Valid {
+		apiHandoff.ToUserID = &dbHandoff.ToUserID.String
+	}
+	if dbHandoff.ResolvedAt.Valid {
+		apiHandoff.ResolvedAt = &dbHandoff.ResolvedAt.Time
+	}
+	return apiHandoff, nil
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"errors"
+
+	apiModels "github.com/seankim658/skullking/internal/models/api"
+	dbModels "github.com/seankim658/skullking/internal/models/database"
+)
+
+func DBPlayerRoundScoreToAPIScore(dbScore *dbModels.PlayerRoundScore) (*apiModels.PlayerRoundScoreResponse, error) {
+	if dbScore == nil {
+		return nil, errors.New("cannot convert nil db player round score to api score")
+	}
+	var tricksTaken *int
+	if dbScore.TricksTaken.Valid {
+		tricks := int(dbScore.TricksTaken.Int32)
+		tricksTaken = &tricks
+	}
+
+	bidAmount := dbScore.BidAmount
+	return &apiModels.PlayerRoundScoreResponse{
+		GamePlayerID:       dbScore.GamePlayerID,
+		BidAmount:          &bidAmount,
+		TricksTaken:        tricksTaken,
+		RoundScore:         dbScore.RoundScore,
+		BonusPointsApplied: dbScore.BonusPointsApplied,
+	}, nil
+}
+
+func DBRoundBonusEventToAPIBonusEvent(dbEvent *dbModels.RoundBonusEvent) (*apiModels.BonusEventResponse, error) {
+	if dbEvent == nil {
+		return nil, errors.New("cannot convert nil db round bonus event to api bonus event")
+	}
+
+	apiEvent := &apiModels.BonusEventResponse{
+		BonusEventID: dbEvent.BonusEventID,
+		GamePlayerID: dbEvent.GamePlayerID,
+		BonusType:    dbEvent.BonusType,
+		CreatedAt:    dbEvent.CreatedAt,
+	}
+	if dbEvent.AllyGamePlayerID.Valid {
+		apiEvent.AllyGamePlayerID = &dbEvent.AllyGamePlayerID.String
+	}
+	return apiEvent, nil
+}
+
+func DBRoundToAPIRound(
+	dbRound *dbModels.Round,
+	dbScores []dbModels.PlayerRoundScore,
+	dbBonusEvents []dbModels.RoundBonusEvent,
+) (*apiModels.RoundResponse, error) {
+	if dbRound == nil {
+		return nil, errors.New("cannot convert nil db round to api round")
+	}
+
+	scores := make([]apiModels.PlayerRoundScoreResponse, 0, len(dbScores))
+	for i := range dbScores {
+		apiScore, err := DBPlayerRoundScoreToAPIScore(&dbScores[i])
+		if err != nil {
+			return nil, err
+		}
+		scores = append(scores, *apiScore)
+	}
+
+	bonusEvents := make([]apiModels.BonusEventResponse, 0, len(dbBonusEvents))
+	for i := range dbBonusEvents {
+		apiEvent, err := DBRoundBonusEventToAPIBonusEvent(&dbBonusEvents[i])
+		if err != nil {
+			return nil, err
+		}
+		bonusEvents = append(bonusEvents, *apiEvent)
+	}
+
+	return &apiModels.RoundResponse{
+		RoundID:               dbRound.RoundID,
+		GameID:                dbRound.GameID,
+		RoundNumber:           dbRound.RoundNumber,
+		CardsDealt:            dbRound.CardsDealt,
+		DealerGamePlayerID:    dbRound.DealerGamePlayerID,
+		Status:                dbRound.Status,
+		IsTiebreakerRound:     dbRound.IsTiebreakerRound,
+		KrakenDiscardedTricks: dbRound.KrakenDiscardedTricks,
+		Version:               dbRound.Version,
+		Scores:                scores,
+		BonusEvents:           bonusEvents,
+		CreatedAt:             dbRound.CreatedAt,
+		UpdatedAt:             dbRound.UpdatedAt,
+	}, nil
+}
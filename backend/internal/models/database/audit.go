@@ -0,0 +1,27 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Tables tracked by the `score_audit_log` table
+const (
+	AuditTableRounds            = "rounds"
+	AuditTablePlayerRoundScores = "player_round_scores"
+)
+
+// Maps to the `score_audit_log` table
+type ScoreAuditEntry struct {
+	AuditID         string         `db:"audit_id"`
+	GameID          string         `db:"game_id"`
+	RoundID         string         `db:"round_id"`
+	GamePlayerID    sql.NullString `db:"game_player_id"`
+	TableName       string         `db:"table_name"`
+	Operation       string         `db:"operation"`
+	FieldName       string         `db:"field_name"`
+	OldValue        sql.NullString `db:"old_value"`
+	NewValue        sql.NullString `db:"new_value"`
+	ChangedByUserID sql.NullString `db:"changed_by_user_id"`
+	ChangedAt       time.Time      `db:"changed_at"`
+}
@@ -14,9 +14,20 @@ type Game struct {
 	Status                       string         `db:"status"`
 	StartingDealerGamePlayerID   sql.NullString `db:"starting_dealer_game_player_id"`
 	PlayerSeatingOrderRandomized bool           `db:"player_seating_order_randomized"`
+	TiebreakerRule               string         `db:"tiebreaker_rule"`
+	Ruleset                      string         `db:"ruleset"`
+	KrakenEnabled                bool           `db:"kraken_enabled"`
+	WhiteWhaleEnabled            bool           `db:"white_whale_enabled"`
+	LootEnabled                  bool           `db:"loot_enabled"`
+	RoundSchedule                string         `db:"round_schedule"`
+	Visibility                   string         `db:"visibility"`
+	Version                      int            `db:"version"`
 	CreatedAt                    time.Time      `db:"created_at"`
 	UpdatedAt                    time.Time      `db:"updated_at"`
+	StartedAt                    sql.NullTime   `db:"started_at"`
 	CompletedAt                  sql.NullTime   `db:"completed_at"`
+	AbandonedAt                  sql.NullTime   `db:"abandoned_at"`
+	AbandonReason                sql.NullString `db:"abandon_reason"`
 }
 
 // Maps to the `game_players` table
@@ -28,6 +39,9 @@ type GamePlayer struct {
 	SeatingOrder      int            `db:"seating_order"`
 	FinalScore        int            `db:"final_score"`
 	FinishingPosition sql.NullInt32  `db:"finishing_position"`
+	LeftAt            sql.NullTime   `db:"left_at"`
+	JoinedAt          time.Time      `db:"joined_at"`
+	JoinedWithCode    bool           `db:"joined_with_code"`
 }
 
 // Maps to the `guest_players` table
@@ -36,3 +50,64 @@ type GuestPlayer struct {
 	DisplayName   string    `db:"display_name"`
 	CreatedAt     time.Time `db:"created_at"`
 }
+
+// Maps to the `player_game_asterisks` table
+type PlayerGameAsterisk struct {
+	PlayerGameAsteriskID string         `db:"player_game_asterisk_id"`
+	GamePlayerID         string         `db:"game_player_id"`
+	GameID               string         `db:"game_id"`
+	Reason               sql.NullString `db:"reason"`
+	CreatedAt            time.Time      `db:"created_at"`
+}
+
+// Scorekeeper handoff types
+const (
+	HandoffTypeTransfer = "transfer"
+	HandoffTypeRequest  = "request"
+)
+
+// Scorekeeper handoff statuses
+const (
+	HandoffStatusPending   = "pending"
+	HandoffStatusCompleted = "completed"
+	HandoffStatusRejected  = "rejected"
+	HandoffStatusCancelled = "cancelled"
+)
+
+// Maps to the `scorekeeper_handoffs` table
+type ScorekeeperHandoff struct {
+	HandoffID   string         `db:"handoff_id"`
+	GameID      string         `db:"game_id"`
+	FromUserID  sql.NullString `db:"from_user_id"`
+	ToUserID    sql.NullString `db:"to_user_id"`
+	HandoffType string         `db:"handoff_type"`
+	Status      string         `db:"status"`
+	CreatedAt   time.Time      `db:"created_at"`
+	ResolvedAt  sql.NullTime   `db:"resolved_at"`
+}
+
+// Valid values for the `sync_actions.status` column
+const (
+	SyncActionStatusApplied  = "applied"
+	SyncActionStatusRejected = "rejected"
+)
+
+// Maps to the `sync_actions` table
+type SyncAction struct {
+	GameID          string         `db:"game_id"`
+	ClientActionID  string         `db:"client_action_id"`
+	ActionType      string         `db:"action_type"`
+	Status          string         `db:"status"`
+	Reason          sql.NullString `db:"reason"`
+	AppliedByUserID sql.NullString `db:"applied_by_user_id"`
+	CreatedAt       time.Time      `db:"created_at"`
+}
+
+// Maps to the `game_join_codes` table
+type GameJoinCode struct {
+	GameID          string         `db:"game_id"`
+	Code            string         `db:"code"`
+	CreatedByUserID sql.NullString `db:"created_by_user_id"`
+	ExpiresAt       time.Time      `db:"expires_at"`
+	CreatedAt       time.Time      `db:"created_at"`
+}
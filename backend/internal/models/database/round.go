@@ -0,0 +1,53 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Maps to the `player_round_scores` table
+type PlayerRoundScore struct {
+	PlayerRoundScoreID string        `db:"player_round_score_id"`
+	RoundID            string        `db:"round_id"`
+	GamePlayerID       string        `db:"game_player_id"`
+	BidAmount          int           `db:"bid_amount"`
+	TricksTaken        sql.NullInt32 `db:"tricks_taken"`
+	RoundScore         int           `db:"round_score"`
+	BonusPointsApplied int           `db:"bonus_points_applied"`
+	CreatedAt          time.Time     `db:"created_at"`
+	UpdatedAt          time.Time     `db:"updated_at"`
+}
+
+// Valid values for the `rounds.status` column
+const (
+	RoundStatusBidding   = "bidding"
+	RoundStatusPlaying   = "playing"
+	RoundStatusCompleted = "completed"
+)
+
+// Maps to the `rounds` table
+type Round struct {
+	RoundID            string `db:"round_id"`
+	GameID             string `db:"game_id"`
+	RoundNumber        int    `db:"round_number"`
+	CardsDealt         int    `db:"cards_dealt"`
+	DealerGamePlayerID string `db:"dealer_game_player_id"`
+	Status             string `db:"status"`
+	IsTiebreakerRound  bool   `db:"is_tiebreaker_round"`
+	// Tricks discarded by the Kraken or the White Whale and won by nobody
+	KrakenDiscardedTricks int          `db:"kraken_discarded_tricks"`
+	BidsRevealedAt        sql.NullTime `db:"bids_revealed_at"` // Set once self-submitted bids can be seen by everyone
+	Version               int          `db:"version"`
+	CreatedAt             time.Time    `db:"created_at"`
+	UpdatedAt             time.Time    `db:"updated_at"`
+}
+
+// Maps to the `round_bonus_events` table
+type RoundBonusEvent struct {
+	BonusEventID     string         `db:"bonus_event_id"`
+	RoundID          string         `db:"round_id"`
+	GamePlayerID     string         `db:"game_player_id"`
+	BonusType        string         `db:"bonus_type"`
+	AllyGamePlayerID sql.NullString `db:"ally_game_player_id"`
+	CreatedAt        time.Time      `db:"created_at"`
+}
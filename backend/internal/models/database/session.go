@@ -14,4 +14,6 @@ type GameSession struct {
 	CreatedAt       time.Time      `db:"created_at"`
 	UpdatedAt       time.Time      `db:"updated_at"`
 	CompletedAt     sql.NullTime   `db:"completed_at"`
+	AbandonedAt     sql.NullTime   `db:"abandoned_at"`
+	AbandonReason   sql.NullString `db:"abandon_reason"`
 }
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Maps to the `share_links` table, exactly one of `GameID` and `SessionID` is set
+type ShareLink struct {
+	ShareLinkID     string         `db:"share_link_id"`
+	GameID          sql.NullString `db:"game_id"`
+	SessionID       sql.NullString `db:"session_id"`
+	CreatedByUserID sql.NullString `db:"created_by_user_id"`
+	CreatedAt       time.Time      `db:"created_at"`
+	RevokedAt       sql.NullTime   `db:"revoked_at"`
+}
@@ -2,6 +2,7 @@ package router
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"golang.org/x/time/rate"
@@ -33,6 +34,9 @@ func New(cfg *cf.Config) http.Handler {
 	// --- API Subrouter ---
 	apiRouter := mainRouter.PathPrefix("/api").Subrouter()
 
+	// Replay the stored response to writes retried with the same Idempotency-Key
+	apiRouter.Use(mw.Idempotency(24 * time.Hour))
+
 	// --- Route Definitions ---
 
 	// Health check endpoint
@@ -62,13 +66,84 @@ func New(cfg *cf.Config) http.Handler {
 	gameHandler := h.NewGameHandler(cfg)
 	gameSubRouter := apiRouter.PathPrefix("/games").Subrouter()
 	gameSubRouter.HandleFunc("", gameHandler.HandleCreateGame).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}", gameHandler.HandleGetGameDetail).Methods(http.MethodGet)
 	gameSubRouter.HandleFunc("/{game_id}/players", gameHandler.HandleAddPlayerToGame).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/players/seating", gameHandler.HandleReorderPlayers).Methods(http.MethodPut)
+	gameSubRouter.HandleFunc("/{game_id}/players/{game_player_id}", gameHandler.HandleReplacePlayer).Methods(http.MethodPut)
+	gameSubRouter.HandleFunc("/{game_id}/players/{game_player_id}", gameHandler.HandleRemovePlayerFromGame).Methods(http.MethodDelete)
+	gameSubRouter.HandleFunc("/{game_id}/players/{game_player_id}/leave", gameHandler.HandleMarkPlayerLeft).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/start", gameHandler.HandleStartGame).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/complete", gameHandler.HandleCompleteGame).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/abandon", gameHandler.HandleAbandonGame).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/undo", gameHandler.HandleUndoLastAction).Methods(http.MethodPost)
+
+	// Round routes
+	roundHandler := h.NewRoundHandler(cfg)
+	gameSubRouter.HandleFunc("/{game_id}/rounds", roundHandler.HandleCreateRound).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/rounds/tiebreaker", roundHandler.HandleCreateTiebreakerRound).Methods(http.MethodPost)
+	roundSubRouter := apiRouter.PathPrefix("/rounds").Subrouter()
+	roundSubRouter.HandleFunc("/{round_id}/bids", roundHandler.HandleSubmitBids).Methods(http.MethodPut)
+	roundSubRouter.HandleFunc("/{round_id}/bids/me", roundHandler.HandleSubmitOwnBid).Methods(http.MethodPut)
+	roundSubRouter.HandleFunc("/{round_id}/bids/reveal", roundHandler.HandleRevealBids).Methods(http.MethodPost)
+	roundSubRouter.HandleFunc("/{round_id}/tricks", roundHandler.HandleSubmitTricks).Methods(http.MethodPut)
+	roundSubRouter.HandleFunc("/{round_id}/scores", roundHandler.HandleCorrectScores).Methods(http.MethodPatch)
+	roundSubRouter.HandleFunc("/{round_id}/bonuses", roundHandler.HandleRecordBonusEvent).Methods(http.MethodPost)
+	roundSubRouter.HandleFunc("/{round_id}/bonuses/{bonus_event_id}", roundHandler.HandleDeleteBonusEvent).Methods(http.MethodDelete)
+
+	// Scorekeeper routes
+	scorekeeperHandler := h.NewScorekeeperHandler(cfg)
+	gameSubRouter.HandleFunc("/{game_id}/scorekeeper/transfer", scorekeeperHandler.HandleTransferScorekeeper).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/scorekeeper/requests", scorekeeperHandler.HandleRequestScorekeeper).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/scorekeeper/requests/{handoff_id}/approve", scorekeeperHandler.HandleApproveScorekeeperRequest).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/scorekeeper/requests/{handoff_id}/reject", scorekeeperHandler.HandleRejectScorekeeperRequest).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/scorekeeper/handoffs", scorekeeperHandler.HandleGetScorekeeperHandoffs).Methods(http.MethodGet)
+
+	// Asterisk routes
+	asteriskHandler := h.NewAsteriskHandler(cfg)
+	gameSubRouter.HandleFunc("/{game_id}/asterisks", asteriskHandler.HandleAddAsterisk).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/asterisks", asteriskHandler.HandleGetAsterisks).Methods(http.MethodGet)
+	gameSubRouter.HandleFunc("/{game_id}/asterisks/{asterisk_id}", asteriskHandler.HandleRemoveAsterisk).Methods(http.MethodDelete)
+
+	// History routes
+	historyHandler := h.NewHistoryHandler(cfg)
+	gameSubRouter.HandleFunc("/{game_id}/history", historyHandler.HandleGetGameHistory).Methods(http.MethodGet)
+	gameSubRouter.HandleFunc("/{game_id}/history/{audit_id}/restore", historyHandler.HandleRestoreScoreAuditEntry).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/timeline", historyHandler.HandleGetGameTimeline).Methods(http.MethodGet)
+
+	// Event routes
+	eventHandler := h.NewEventHandler(cfg)
+	gameSubRouter.HandleFunc("/{game_id}/events", eventHandler.HandleStreamGameEvents).Methods(http.MethodGet)
+
+	// Sync routes
+	syncHandler := h.NewSyncHandler(cfg)
+	gameSubRouter.HandleFunc("/{game_id}/sync", syncHandler.HandleSyncGame).Methods(http.MethodPost)
+
+	// Join code routes
+	joinHandler := h.NewJoinHandler(cfg)
+	gameSubRouter.HandleFunc("/join/{code}", joinHandler.HandleJoinGame).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/join-code", joinHandler.HandleCreateJoinCode).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/join-code", joinHandler.HandleGetJoinCode).Methods(http.MethodGet)
+	gameSubRouter.HandleFunc("/{game_id}/join-code", joinHandler.HandleDeleteJoinCode).Methods(http.MethodDelete)
+
+	// Share link routes
+	shareHandler := h.NewShareHandler(cfg)
+	gameSubRouter.HandleFunc("/{game_id}/share-links", shareHandler.HandleCreateGameShareLink).Methods(http.MethodPost)
+	gameSubRouter.HandleFunc("/{game_id}/share-links", shareHandler.HandleGetGameShareLinks).Methods(http.MethodGet)
+	gameSubRouter.HandleFunc("/{game_id}/share-links/{share_link_id}", shareHandler.HandleRevokeGameShareLink).Methods(http.MethodDelete)
+	sharedSubRouter := apiRouter.PathPrefix("/shared").Subrouter()
+	sharedSubRouter.HandleFunc("/{token}", shareHandler.HandleGetSharedView).Methods(http.MethodGet)
+	sharedSubRouter.HandleFunc("/{token}/games/{game_id}", shareHandler.HandleGetSharedGame).Methods(http.MethodGet)
+	sharedSubRouter.HandleFunc("/{token}/games/{game_id}/events", shareHandler.HandleStreamSharedGameEvents).Methods(http.MethodGet)
 
 	// Session routes
 	sessionHandler := h.NewSessionHandler(cfg)
 	sessionSubRouter := apiRouter.PathPrefix("/sessions").Subrouter()
 	sessionSubRouter.HandleFunc("/active", sessionHandler.HandleGetActiveSessionsForUser).Methods(http.MethodGet)
 	sessionSubRouter.HandleFunc("/{session_id}/complete", sessionHandler.HandleCompleteSession).Methods(http.MethodPut)
+	sessionSubRouter.HandleFunc("/{session_id}/abandon", sessionHandler.HandleAbandonSession).Methods(http.MethodPut)
+	sessionSubRouter.HandleFunc("/{session_id}/share-links", shareHandler.HandleCreateSessionShareLink).Methods(http.MethodPost)
+	sessionSubRouter.HandleFunc("/{session_id}/share-links", shareHandler.HandleGetSessionShareLinks).Methods(http.MethodGet)
+	sessionSubRouter.HandleFunc("/{session_id}/share-links/{share_link_id}", shareHandler.HandleRevokeSessionShareLink).Methods(http.MethodDelete)
 
 	// User profile routes
 	userHandler := h.NewUserProfileHandler(cfg)
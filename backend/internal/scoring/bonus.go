@@ -0,0 +1,140 @@
+package scoring
+
+import "errors"
+
+// Valid values for the `round_bonus_events.bonus_type` column
+const (
+	// Capturing a standard suit (yellow, purple or green) 14
+	BonusTypeStandardFourteen = "standard_fourteen"
+	// Capturing the black (Jolly Roger) 14
+	BonusTypeBlackFourteen = "black_fourteen"
+	// Capturing a Mermaid with a Pirate
+	BonusTypeMermaidCapturedByPirate = "mermaid_captured_by_pirate"
+	// Capturing a Pirate with the Skull King
+	BonusTypePirateCapturedBySkullKing = "pirate_captured_by_skull_king"
+	// Capturing the Skull King with a Mermaid
+	BonusTypeSkullKingCapturedByMermaid = "skull_king_captured_by_mermaid"
+	// Winning a trick containing another player's Loot card, forming an alliance with them
+	BonusTypeLootAlliance = "loot_alliance"
+)
+
+var bonusPointsByType = map[string]int{
+	BonusTypeStandardFourteen:           10,
+	BonusTypeBlackFourteen:              20,
+	BonusTypeMermaidCapturedByPirate:    20,
+	BonusTypePirateCapturedBySkullKing:  30,
+	BonusTypeSkullKingCapturedByMermaid: 40,
+	BonusTypeLootAlliance:               20,
+}
+
+// The most times a bonus can be earned in a round, one for each card in the deck it captures.
+// Pirates captured by the Skull King aren't limited, the Tigress can be played as a Pirate.
+var maxBonusesPerRound = map[string]int{
+	BonusTypeStandardFourteen:           3,
+	BonusTypeBlackFourteen:              1,
+	BonusTypeMermaidCapturedByPirate:    2,
+	BonusTypeSkullKingCapturedByMermaid: 1,
+	BonusTypeLootAlliance:               2,
+}
+
+var (
+	ErrUnknownBonusType   = errors.New("unknown bonus type")
+	ErrAllyRequired       = errors.New("a loot alliance requires an ally")
+	ErrAllyNotAllowed     = errors.New("only a loot alliance can have an ally")
+	ErrAllyIsCapturer     = errors.New("a player cannot form a loot alliance with themselves")
+	ErrUnknownBonusPlayer = errors.New("bonus event references a player who is not in the round")
+	ErrBonusLimitReached  = errors.New("this bonus has already been earned as many times as its cards are in the deck")
+	ErrBonusWithoutTricks = errors.New("a bonus can only be earned by a player who took at least one trick")
+)
+
+// A single bonus earned during a round
+type BonusEvent struct {
+	BonusType string
+	// The player who won the trick the bonus was earned in
+	GamePlayerID string
+	// The player who played the Loot card, only set for a loot alliance
+	AllyGamePlayerID string
+}
+
+// Reports whether the bonus type is a supported value
+func IsValidBonusType(bonusType string) bool {
+	_, ok := bonusPointsByType[bonusType]
+	return ok
+}
+
+// Returns the points a bonus is worth to each player who earns it
+func BonusPoints(bonusType string) (int, error) {
+	points, ok := bonusPointsByType[bonusType]
+	if !ok {
+		return 0, ErrUnknownBonusType
+	}
+	return points, nil
+}
+
+// Validates the shape of a bonus event, only a loot alliance has an ally
+func ValidateBonusEvent(event BonusEvent) error {
+	if !IsValidBonusType(event.BonusType) {
+		return ErrUnknownBonusType
+	}
+	if event.BonusType != BonusTypeLootAlliance {
+		if event.AllyGamePlayerID != "" {
+			return ErrAllyNotAllowed
+		}
+		return nil
+	}
+	if event.AllyGamePlayerID == "" {
+		return ErrAllyRequired
+	}
+	if event.AllyGamePlayerID == event.GamePlayerID {
+		return ErrAllyIsCapturer
+	}
+	return nil
+}
+
+// Validates the bonuses of a round together: no bonus is earned more often than the cards it
+// captures are in the deck, and every player earning one took a trick. Players missing from
+// tricksTaken haven't had their tricks recorded yet and aren't checked.
+func ValidateRoundBonuses(events []BonusEvent, tricksTaken map[string]int) error {
+	counts := make(map[string]int, len(events))
+	for _, event := range events {
+		counts[event.BonusType]++
+		if limit, ok := maxBonusesPerRound[event.BonusType]; ok && counts[event.BonusType] > limit {
+			return ErrBonusLimitReached
+		}
+		if tricks, ok := tricksTaken[event.GamePlayerID]; ok && tricks == 0 {
+			return ErrBonusWithoutTricks
+		}
+	}
+	return nil
+}
+
+// Totals the bonus points earned by each player in a round from its bonus events. Both players
+// in a loot alliance earn its points, but only when their ally also made their bid. Whether
+// the player themselves made their bid is left to CalculateRoundScore. bidMade must contain
+// every player in the round.
+func RoundBonusPoints(events []BonusEvent, bidMade map[string]bool) (map[string]int, error) {
+	totals := make(map[string]int, len(bidMade))
+	for _, event := range events {
+		if err := ValidateBonusEvent(event); err != nil {
+			return nil, err
+		}
+		if _, ok := bidMade[event.GamePlayerID]; !ok {
+			return nil, ErrUnknownBonusPlayer
+		}
+		points, _ := BonusPoints(event.BonusType)
+
+		if event.BonusType != BonusTypeLootAlliance {
+			totals[event.GamePlayerID] += points
+			continue
+		}
+		allyMade, ok := bidMade[event.AllyGamePlayerID]
+		if !ok {
+			return nil, ErrUnknownBonusPlayer
+		}
+		if allyMade && bidMade[event.GamePlayerID] {
+			totals[event.GamePlayerID] += points
+			totals[event.AllyGamePlayerID] += points
+		}
+	}
+	return totals, nil
+}
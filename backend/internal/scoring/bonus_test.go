@@ -0,0 +1,159 @@
+package scoring
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValidateBonusEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   BonusEvent
+		wantErr error
+	}{
+		{name: "standard fourteen", event: BonusEvent{BonusType: BonusTypeStandardFourteen, GamePlayerID: "a"}},
+		{name: "loot alliance", event: BonusEvent{BonusType: BonusTypeLootAlliance, GamePlayerID: "a", AllyGamePlayerID: "b"}},
+		{name: "unknown type", event: BonusEvent{BonusType: "kraken_captured", GamePlayerID: "a"}, wantErr: ErrUnknownBonusType},
+		{
+			name:    "ally on a non-alliance bonus",
+			event:   BonusEvent{BonusType: BonusTypeBlackFourteen, GamePlayerID: "a", AllyGamePlayerID: "b"},
+			wantErr: ErrAllyNotAllowed,
+		},
+		{name: "alliance without an ally", event: BonusEvent{BonusType: BonusTypeLootAlliance, GamePlayerID: "a"}, wantErr: ErrAllyRequired},
+		{
+			name:    "alliance with themselves",
+			event:   BonusEvent{BonusType: BonusTypeLootAlliance, GamePlayerID: "a", AllyGamePlayerID: "a"},
+			wantErr: ErrAllyIsCapturer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateBonusEvent(tt.event); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateBonusEvent() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRoundBonuses(t *testing.T) {
+	standard := BonusEvent{BonusType: BonusTypeStandardFourteen, GamePlayerID: "a"}
+	black := BonusEvent{BonusType: BonusTypeBlackFourteen, GamePlayerID: "a"}
+	pirate := BonusEvent{BonusType: BonusTypePirateCapturedBySkullKing, GamePlayerID: "a"}
+
+	tests := []struct {
+		name        string
+		events      []BonusEvent
+		tricksTaken map[string]int
+		wantErr     error
+	}{
+		{name: "no bonuses"},
+		{name: "every standard fourteen", events: []BonusEvent{standard, standard, standard}},
+		{name: "fourth standard fourteen", events: []BonusEvent{standard, standard, standard, standard}, wantErr: ErrBonusLimitReached},
+		{name: "second black fourteen", events: []BonusEvent{black, black}, wantErr: ErrBonusLimitReached},
+		{
+			name: "third mermaid captured by a pirate",
+			events: []BonusEvent{
+				{BonusType: BonusTypeMermaidCapturedByPirate, GamePlayerID: "a"},
+				{BonusType: BonusTypeMermaidCapturedByPirate, GamePlayerID: "b"},
+				{BonusType: BonusTypeMermaidCapturedByPirate, GamePlayerID: "a"},
+			},
+			wantErr: ErrBonusLimitReached,
+		},
+		{name: "pirates captured by the skull king are unlimited", events: []BonusEvent{pirate, pirate, pirate, pirate, pirate, pirate}},
+		{
+			name:        "captor took a trick",
+			events:      []BonusEvent{standard, black},
+			tricksTaken: map[string]int{"a": 1, "b": 0},
+		},
+		{
+			name:        "captor took no tricks",
+			events:      []BonusEvent{{BonusType: BonusTypeBlackFourteen, GamePlayerID: "b"}},
+			tricksTaken: map[string]int{"a": 1, "b": 0},
+			wantErr:     ErrBonusWithoutTricks,
+		},
+		{
+			name:        "captor tricks not recorded yet",
+			events:      []BonusEvent{{BonusType: BonusTypeBlackFourteen, GamePlayerID: "c"}},
+			tricksTaken: map[string]int{"a": 1, "b": 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateRoundBonuses(tt.events, tt.tricksTaken); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateRoundBonuses() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRoundBonusPoints(t *testing.T) {
+	tests := []struct {
+		name    string
+		events  []BonusEvent
+		bidMade map[string]bool
+		want    map[string]int
+		wantErr error
+	}{
+		{
+			name: "bonuses add up per player",
+			events: []BonusEvent{
+				{BonusType: BonusTypeStandardFourteen, GamePlayerID: "a"},
+				{BonusType: BonusTypeSkullKingCapturedByMermaid, GamePlayerID: "a"},
+				{BonusType: BonusTypePirateCapturedBySkullKing, GamePlayerID: "b"},
+			},
+			bidMade: map[string]bool{"a": true, "b": false},
+			want:    map[string]int{"a": 50, "b": 30},
+		},
+		{
+			name:    "loot alliance when both made their bid",
+			events:  []BonusEvent{{BonusType: BonusTypeLootAlliance, GamePlayerID: "a", AllyGamePlayerID: "b"}},
+			bidMade: map[string]bool{"a": true, "b": true},
+			want:    map[string]int{"a": 20, "b": 20},
+		},
+		{
+			name:    "loot alliance when the ally missed their bid",
+			events:  []BonusEvent{{BonusType: BonusTypeLootAlliance, GamePlayerID: "a", AllyGamePlayerID: "b"}},
+			bidMade: map[string]bool{"a": true, "b": false},
+			want:    map[string]int{},
+		},
+		{
+			name:    "loot alliance when the captor missed their bid",
+			events:  []BonusEvent{{BonusType: BonusTypeLootAlliance, GamePlayerID: "a", AllyGamePlayerID: "b"}},
+			bidMade: map[string]bool{"a": false, "b": true},
+			want:    map[string]int{},
+		},
+		{
+			name:    "captor not in the round",
+			events:  []BonusEvent{{BonusType: BonusTypeStandardFourteen, GamePlayerID: "c"}},
+			bidMade: map[string]bool{"a": true},
+			wantErr: ErrUnknownBonusPlayer,
+		},
+		{
+			name:    "ally not in the round",
+			events:  []BonusEvent{{BonusType: BonusTypeLootAlliance, GamePlayerID: "a", AllyGamePlayerID: "c"}},
+			bidMade: map[string]bool{"a": true},
+			wantErr: ErrUnknownBonusPlayer,
+		},
+		{
+			name:    "invalid event",
+			events:  []BonusEvent{{BonusType: BonusTypeLootAlliance, GamePlayerID: "a"}},
+			bidMade: map[string]bool{"a": true},
+			wantErr: ErrAllyRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RoundBonusPoints(tt.events, tt.bidMade)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RoundBonusPoints() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RoundBonusPoints() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
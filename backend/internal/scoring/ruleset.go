@@ -0,0 +1,82 @@
+package scoring
+
+import "errors"
+
+// Valid values for the `games.ruleset` column
+const (
+	RulesetClassic = "classic"
+	RulesetRascal  = "rascal"
+)
+
+var (
+	ErrUnknownRuleset        = errors.New("unknown ruleset")
+	ErrBonusTypeNotInRuleset = errors.New("bonus type is not available in this game's ruleset")
+)
+
+// The rules a game is played and scored with, the scoring system plus any expansion cards
+// in the deck
+type Ruleset struct {
+	Name string
+	// The Kraken destroys the trick it is played in, so nobody takes it
+	Kraken bool
+	// The White Whale cancels the special cards in its trick, a trick of only special cards
+	// is discarded and taken by nobody
+	WhiteWhale bool
+	// Loot cards form alliances between players
+	Loot bool
+}
+
+// Reports whether the ruleset name is a supported value
+func IsValidRuleset(name string) bool {
+	switch name {
+	case RulesetClassic, RulesetRascal:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validates the ruleset has a supported scoring system
+func (rs Ruleset) Validate() error {
+	if !IsValidRuleset(rs.Name) {
+		return ErrUnknownRuleset
+	}
+	return nil
+}
+
+// The most tricks that can be discarded in a round, one for each of the Kraken and the White
+// Whale in the deck
+func (rs Ruleset) MaxDiscardedTricks() int {
+	discards := 0
+	if rs.Kraken {
+		discards++
+	}
+	if rs.WhiteWhale {
+		discards++
+	}
+	return discards
+}
+
+// Reports whether a bonus can be earned under the ruleset, a loot alliance needs the Loot
+// expansion cards
+func (rs Ruleset) AllowsBonusType(bonusType string) bool {
+	if !IsValidBonusType(bonusType) {
+		return false
+	}
+	if bonusType == BonusTypeLootAlliance {
+		return rs.Loot
+	}
+	return true
+}
+
+// Calculates a player's score for a round with the ruleset's scoring system
+func (rs Ruleset) CalculateRoundScore(cardsDealt, bid, tricksTaken, bonusPoints int) (RoundScore, error) {
+	switch rs.Name {
+	case RulesetClassic:
+		return CalculateRoundScore(cardsDealt, bid, tricksTaken, bonusPoints)
+	case RulesetRascal:
+		return CalculateRascalRoundScore(cardsDealt, bid, tricksTaken, bonusPoints)
+	default:
+		return RoundScore{}, ErrUnknownRuleset
+	}
+}
@@ -0,0 +1,112 @@
+package scoring
+
+import "errors"
+
+// Points awarded per trick when a non-zero bid is made exactly
+const PointsPerBidTrick = 20
+
+// Points lost per trick a non-zero bid is missed by
+const PointsPerMissedTrick = 10
+
+// Points won or lost per card dealt on a zero bid
+const PointsPerZeroBidCard = 10
+
+// Potential points per card dealt under Rascal's scoring
+const RascalPointsPerCard = 10
+
+var (
+	ErrInvalidCardsDealt = errors.New("cards dealt must be a positive integer")
+	ErrNegativeBid       = errors.New("bid amount cannot be negative")
+	ErrNegativeTricks    = errors.New("tricks taken cannot be negative")
+	ErrNegativeBonus     = errors.New("bonus points cannot be negative")
+)
+
+// The computed result for a single player's round, maps onto the `round_score` and
+// `bonus_points_applied` columns of the `player_round_scores` table
+type RoundScore struct {
+	// Total points for the round, including any applied bonus points
+	RoundScore int
+	// Bonus points that counted towards the round score (zero if the bid was missed)
+	BonusPointsApplied int
+}
+
+// Reports whether a player took exactly the number of tricks they bid
+func BidMade(bid, tricksTaken int) bool {
+	return bid == tricksTaken
+}
+
+// Calculates a player's score for a round using the official Skull King rules:
+//   - Bid made (non-zero): 20 points per trick bid
+//   - Bid missed (non-zero): -10 points per trick over or under the bid
+//   - Zero bid made: +10 points per card dealt
+//   - Zero bid missed: -10 points per card dealt
+//
+// Bonus points are only applied when the bid was made.
+func CalculateRoundScore(cardsDealt, bid, tricksTaken, bonusPoints int) (RoundScore, error) {
+	if err := validateRoundInputs(cardsDealt, bid, tricksTaken, bonusPoints); err != nil {
+		return RoundScore{}, err
+	}
+
+	made := BidMade(bid, tricksTaken)
+
+	var base int
+	switch {
+	case bid == 0 && made:
+		base = PointsPerZeroBidCard * cardsDealt
+	case bid == 0:
+		base = -PointsPerZeroBidCard * cardsDealt
+	case made:
+		base = PointsPerBidTrick * bid
+	default:
+		base = -PointsPerMissedTrick * abs(bid-tricksTaken)
+	}
+
+	if !made {
+		return RoundScore{RoundScore: base}, nil
+	}
+	return RoundScore{RoundScore: base + bonusPoints, BonusPointsApplied: bonusPoints}, nil
+}
+
+// Calculates a player's score for a round using Rascal's ("Brendan's") scoring, where every
+// player risks the same potential points of 10 per card dealt whatever their bid:
+//   - Direct hit (bid made): the full potential points plus any bonus points
+//   - Glancing blow (off by one): half the potential points, no bonus points
+//   - Miss (off by two or more): no points
+func CalculateRascalRoundScore(cardsDealt, bid, tricksTaken, bonusPoints int) (RoundScore, error) {
+	if err := validateRoundInputs(cardsDealt, bid, tricksTaken, bonusPoints); err != nil {
+		return RoundScore{}, err
+	}
+
+	potential := RascalPointsPerCard * cardsDealt
+	switch abs(bid - tricksTaken) {
+	case 0:
+		return RoundScore{RoundScore: potential + bonusPoints, BonusPointsApplied: bonusPoints}, nil
+	case 1:
+		return RoundScore{RoundScore: potential / 2}, nil
+	default:
+		return RoundScore{}, nil
+	}
+}
+
+func validateRoundInputs(cardsDealt, bid, tricksTaken, bonusPoints int) error {
+	if cardsDealt <= 0 {
+		return ErrInvalidCardsDealt
+	}
+	if bid < 0 {
+		return ErrNegativeBid
+	}
+	if tricksTaken < 0 {
+		return ErrNegativeTricks
+	}
+	if bonusPoints < 0 {
+		return ErrNegativeBonus
+	}
+	return nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
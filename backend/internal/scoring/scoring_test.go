@@ -0,0 +1,138 @@
+package scoring
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCalculateRoundScore(t *testing.T) {
+	tests := []struct {
+		name        string
+		cardsDealt  int
+		bid         int
+		tricksTaken int
+		bonusPoints int
+		want        RoundScore
+		wantErr     error
+	}{
+		{name: "bid made", cardsDealt: 5, bid: 2, tricksTaken: 2, want: RoundScore{RoundScore: 40}},
+		{name: "bid missed under", cardsDealt: 5, bid: 3, tricksTaken: 1, want: RoundScore{RoundScore: -20}},
+		{name: "bid missed over", cardsDealt: 7, bid: 2, tricksTaken: 5, want: RoundScore{RoundScore: -30}},
+		{name: "zero bid made", cardsDealt: 5, bid: 0, tricksTaken: 0, want: RoundScore{RoundScore: 50}},
+		{name: "zero bid missed", cardsDealt: 5, bid: 0, tricksTaken: 1, want: RoundScore{RoundScore: -50}},
+		{name: "zero bid missed by several", cardsDealt: 9, bid: 0, tricksTaken: 3, want: RoundScore{RoundScore: -90}},
+		{name: "zero bid made in the first round", cardsDealt: 1, bid: 0, tricksTaken: 0, want: RoundScore{RoundScore: 10}},
+		{
+			name:       "bonus applied when bid made",
+			cardsDealt: 6, bid: 3, tricksTaken: 3, bonusPoints: 30,
+			want: RoundScore{RoundScore: 90, BonusPointsApplied: 30},
+		},
+		{
+			name:       "bonus applied to zero bid made",
+			cardsDealt: 4, bid: 0, tricksTaken: 0, bonusPoints: 10,
+			want: RoundScore{RoundScore: 50, BonusPointsApplied: 10},
+		},
+		{name: "bonus dropped when bid missed", cardsDealt: 6, bid: 3, tricksTaken: 2, bonusPoints: 30, want: RoundScore{RoundScore: -10}},
+		{name: "invalid cards dealt", cardsDealt: 0, bid: 0, tricksTaken: 0, wantErr: ErrInvalidCardsDealt},
+		{name: "negative bid", cardsDealt: 3, bid: -1, tricksTaken: 0, wantErr: ErrNegativeBid},
+		{name: "negative tricks", cardsDealt: 3, bid: 1, tricksTaken: -1, wantErr: ErrNegativeTricks},
+		{name: "negative bonus", cardsDealt: 3, bid: 1, tricksTaken: 1, bonusPoints: -10, wantErr: ErrNegativeBonus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CalculateRoundScore(tt.cardsDealt, tt.bid, tt.tricksTaken, tt.bonusPoints)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CalculateRoundScore() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CalculateRoundScore() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateRascalRoundScore(t *testing.T) {
+	tests := []struct {
+		name        string
+		cardsDealt  int
+		bid         int
+		tricksTaken int
+		bonusPoints int
+		want        RoundScore
+		wantErr     error
+	}{
+		{name: "direct hit", cardsDealt: 5, bid: 2, tricksTaken: 2, want: RoundScore{RoundScore: 50}},
+		{name: "direct hit on zero bid", cardsDealt: 5, bid: 0, tricksTaken: 0, want: RoundScore{RoundScore: 50}},
+		{
+			name:       "direct hit with bonus",
+			cardsDealt: 5, bid: 2, tricksTaken: 2, bonusPoints: 20,
+			want: RoundScore{RoundScore: 70, BonusPointsApplied: 20},
+		},
+		{name: "glancing blow under", cardsDealt: 5, bid: 2, tricksTaken: 1, want: RoundScore{RoundScore: 25}},
+		{name: "glancing blow over", cardsDealt: 8, bid: 2, tricksTaken: 3, bonusPoints: 20, want: RoundScore{RoundScore: 40}},
+		{name: "glancing blow on zero bid", cardsDealt: 3, bid: 0, tricksTaken: 1, want: RoundScore{RoundScore: 15}},
+		{name: "miss", cardsDealt: 5, bid: 3, tricksTaken: 1, bonusPoints: 10, want: RoundScore{}},
+		{name: "invalid cards dealt", cardsDealt: -1, bid: 0, tricksTaken: 0, wantErr: ErrInvalidCardsDealt},
+		{name: "negative bid", cardsDealt: 3, bid: -2, tricksTaken: 0, wantErr: ErrNegativeBid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CalculateRascalRoundScore(tt.cardsDealt, tt.bid, tt.tricksTaken, tt.bonusPoints)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CalculateRascalRoundScore() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CalculateRascalRoundScore() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRulesetCalculateRoundScore(t *testing.T) {
+	tests := []struct {
+		name    string
+		ruleset Ruleset
+		want    RoundScore
+		wantErr error
+	}{
+		{name: "classic", ruleset: Ruleset{Name: RulesetClassic}, want: RoundScore{RoundScore: -10}},
+		{name: "rascal", ruleset: Ruleset{Name: RulesetRascal}, want: RoundScore{RoundScore: 30}},
+		{name: "unknown", ruleset: Ruleset{Name: "house"}, wantErr: ErrUnknownRuleset},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Six cards dealt, bid of 2 and 3 tricks taken
+			got, err := tt.ruleset.CalculateRoundScore(6, 2, 3, 0)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CalculateRoundScore() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CalculateRoundScore() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRulesetMaxDiscardedTricks(t *testing.T) {
+	tests := []struct {
+		name    string
+		ruleset Ruleset
+		want    int
+	}{
+		{name: "base game", ruleset: Ruleset{Name: RulesetClassic}, want: 0},
+		{name: "kraken", ruleset: Ruleset{Name: RulesetClassic, Kraken: true}, want: 1},
+		{name: "white whale", ruleset: Ruleset{Name: RulesetClassic, WhiteWhale: true}, want: 1},
+		{name: "kraken and white whale", ruleset: Ruleset{Name: RulesetRascal, Kraken: true, WhiteWhale: true}, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ruleset.MaxDiscardedTricks(); got != tt.want {
+				t.Errorf("MaxDiscardedTricks() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
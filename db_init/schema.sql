@@ -52,7 +52,9 @@ CREATE TABLE game_sessions (
   status VARCHAR(50) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'completed', 'abandoned')),
   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
-  completed_at TIMESTAMPTZ
+  completed_at TIMESTAMPTZ,
+  abandoned_at TIMESTAMPTZ,
+  abandon_reason VARCHAR(255)
 );
 
 -- Guest Players Table
@@ -72,8 +74,13 @@ CREATE TABLE game_players (
   seating_order INTEGER NOT NULL CHECK (seating_order > 0),
   final_score INTEGER NOT NULL DEFAULT 0,
   finishing_position INTEGER CHECK (finishing_position IS NULL OR finishing_position > 0),
+  left_at TIMESTAMPTZ, -- Set when a player leaves a game that is in progress
+  joined_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  joined_with_code BOOLEAN NOT NULL DEFAULT FALSE, -- Set when the player added themselves with the game's join code
   CONSTRAINT uq_game_user UNIQUE (game_id, user_id),
   CONSTRAINT uq_game_guest UNIQUE (game_id, guest_player_id),
+  -- Checked at the end of each statement so a single update can rearrange the whole table
+  CONSTRAINT uq_game_seating_order UNIQUE (game_id, seating_order) DEFERRABLE INITIALLY IMMEDIATE,
   CONSTRAINT chk_player_type CHECK (user_id IS NOT NULL OR guest_player_id IS NOT NULL)
 );
 
@@ -86,9 +93,25 @@ CREATE TABLE games (
   status VARCHAR(50) NOT NULL CHECK (status IN ('pending', 'active', 'completed', 'abandoned')),
   starting_dealer_game_player_id UUID REFERENCES game_players(game_player_id) ON DELETE SET NULL,
   player_seating_order_randomized BOOLEAN NOT NULL DEFAULT TRUE,
+  tiebreaker_rule VARCHAR(50) NOT NULL DEFAULT 'shared_victory' CHECK (tiebreaker_rule IN ('shared_victory', 'tiebreaker_round')),
+  ruleset VARCHAR(50) NOT NULL DEFAULT 'classic' CHECK (ruleset IN ('classic', 'rascal')),
+  kraken_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  white_whale_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  loot_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  -- Round structure from the rulebook, decides how many rounds are played and the cards dealt in each
+  round_schedule VARCHAR(50) NOT NULL DEFAULT 'standard' CHECK (round_schedule IN (
+    'standard', 'even_keeled', 'skip_to_the_brawl', 'swift_n_salty', 'broadside_barrage'
+  )),
+  -- Who besides the participants can view the game
+  visibility VARCHAR(50) NOT NULL DEFAULT 'participants' CHECK (visibility IN ('participants', 'friends', 'public')),
+  -- Incremented on every change to the game, its players, asterisks or rounds, sent to clients as the ETag
+  version INTEGER NOT NULL DEFAULT 1,
   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
-  completed_at TIMESTAMPTZ
+  started_at TIMESTAMPTZ,
+  completed_at TIMESTAMPTZ,
+  abandoned_at TIMESTAMPTZ,
+  abandon_reason VARCHAR(255)
 );
 
 -- Add the foreign key from game_players to games now that the game table exists
@@ -103,9 +126,16 @@ CREATE TABLE rounds (
   round_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
   game_id UUID NOT NULL REFERENCES games(game_id) ON DELETE CASCADE,
   round_number INTEGER NOT NULL CHECK (round_number > 0),
+  cards_dealt INTEGER NOT NULL CHECK (cards_dealt > 0),
   dealer_game_player_id UUID NOT NULL REFERENCES game_players(game_player_id) ON DELETE RESTRICT,
   status VARCHAR(50) NOT NULL CHECK (status IN ('bidding', 'playing', 'completed')),
   is_tiebreaker_round BOOLEAN NOT NULL DEFAULT FALSE,
+  -- Tricks destroyed by the Kraken and won by nobody
+  kraken_discarded_tricks INTEGER NOT NULL DEFAULT 0 CHECK (kraken_discarded_tricks >= 0),
+  -- Bids players submit themselves stay hidden while the round is bidding until this is set
+  bids_revealed_at TIMESTAMPTZ,
+  -- Incremented on every change to the round, its scores or its bonuses, sent to clients as the ETag
+  version INTEGER NOT NULL DEFAULT 1,
   created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
   updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
   CONSTRAINT uq_game_round UNIQUE (game_id, round_number)
@@ -125,6 +155,96 @@ CREATE TABLE player_round_scores (
   CONSTRAINT uq_round_player UNIQUE (round_id, game_player_id)
 );
 
+-- Round Bonus Events Table
+CREATE TABLE round_bonus_events (
+  bonus_event_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  round_id UUID NOT NULL REFERENCES rounds(round_id) ON DELETE CASCADE,
+  -- The player who captured the bonus
+  game_player_id UUID NOT NULL REFERENCES game_players(game_player_id) ON DELETE CASCADE,
+  bonus_type VARCHAR(50) NOT NULL CHECK (bonus_type IN (
+    'standard_fourteen', 'black_fourteen', 'mermaid_captured_by_pirate',
+    'pirate_captured_by_skull_king', 'skull_king_captured_by_mermaid', 'loot_alliance'
+  )),
+  -- The player who played the Loot card, only set for a loot alliance
+  ally_game_player_id UUID REFERENCES game_players(game_player_id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT chk_bonus_ally CHECK (
+    (bonus_type = 'loot_alliance' AND ally_game_player_id IS NOT NULL AND ally_game_player_id <> game_player_id)
+    OR (bonus_type <> 'loot_alliance' AND ally_game_player_id IS NULL)
+  )
+);
+
+-- Score Audit Log Table
+-- Written by triggers on every change to the rounds and player_round_scores tables
+CREATE TABLE score_audit_log (
+  audit_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  game_id UUID NOT NULL REFERENCES games(game_id) ON DELETE CASCADE,
+  -- No foreign key so the history of a removed round is kept
+  round_id UUID NOT NULL,
+  -- NULL for changes to the round itself
+  game_player_id UUID REFERENCES game_players(game_player_id) ON DELETE CASCADE,
+  table_name VARCHAR(50) NOT NULL CHECK (table_name IN ('rounds', 'player_round_scores')),
+  operation VARCHAR(10) NOT NULL CHECK (operation IN ('INSERT', 'UPDATE', 'DELETE')),
+  field_name VARCHAR(50) NOT NULL,
+  old_value TEXT,
+  new_value TEXT,
+  changed_by_user_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+  changed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Scorekeeper Handoffs Table
+-- A 'transfer' is started by the current scorekeeper and completes immediately, a 'request' is
+-- started by another player and waits for the current scorekeeper to approve or reject it
+CREATE TABLE scorekeeper_handoffs (
+  handoff_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  game_id UUID NOT NULL REFERENCES games(game_id) ON DELETE CASCADE,
+  from_user_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+  to_user_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+  handoff_type VARCHAR(50) NOT NULL CHECK (handoff_type IN ('transfer', 'request')),
+  status VARCHAR(50) NOT NULL CHECK (status IN ('pending', 'completed', 'rejected', 'cancelled')),
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  resolved_at TIMESTAMPTZ
+);
+
+-- Sync Actions Table
+-- Records the outcome of each client action sent through a batch sync so retried batches skip
+-- actions that were already applied, rejected actions are tried again when sent again
+CREATE TABLE sync_actions (
+  game_id UUID NOT NULL REFERENCES games(game_id) ON DELETE CASCADE,
+  client_action_id UUID NOT NULL,
+  action_type VARCHAR(50) NOT NULL CHECK (action_type IN ('add_player', 'start_round', 'submit_bids', 'submit_tricks')),
+  status VARCHAR(50) NOT NULL CHECK (status IN ('applied', 'rejected')),
+  reason VARCHAR(255),
+  applied_by_user_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (game_id, client_action_id)
+);
+
+-- Game Join Codes Table
+-- A short code players enter, or scan as a QR code, to add themselves to a pending game. A game
+-- has at most one code, creating a new one replaces it.
+CREATE TABLE game_join_codes (
+  game_id UUID PRIMARY KEY REFERENCES games(game_id) ON DELETE CASCADE,
+  code VARCHAR(16) NOT NULL,
+  created_by_user_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT uq_game_join_codes_code UNIQUE (code)
+);
+
+-- Share Links Table
+-- Read-only access for spectators without an account to a game, or to every game in a session.
+-- The link's token is signed by the server, revoking the link stops the token from working.
+CREATE TABLE share_links (
+  share_link_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  game_id UUID REFERENCES games(game_id) ON DELETE CASCADE,
+  session_id UUID REFERENCES game_sessions(session_id) ON DELETE CASCADE,
+  created_by_user_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+  revoked_at TIMESTAMPTZ,
+  CONSTRAINT chk_share_link_target CHECK ((game_id IS NULL) <> (session_id IS NULL))
+);
+
 -- Player Game Asterisks Table
 CREATE TABLE player_game_asterisks (
   player_game_asterisk_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -191,6 +311,180 @@ BEFORE UPDATE ON player_round_scores
 FOR EACH ROW
 EXECUTE FUNCTION trigger_set_timestamp();
 
+-- Functions to track versions for optimistic concurrency
+CREATE OR REPLACE FUNCTION trigger_bump_version()
+RETURNS TRIGGER AS $$
+BEGIN
+  NEW.version = OLD.version + 1;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Touches the parent round of a changed score or bonus, bumping its version
+CREATE OR REPLACE FUNCTION trigger_touch_round()
+RETURNS TRIGGER AS $$
+BEGIN
+  UPDATE rounds SET updated_at = NOW() WHERE round_id = COALESCE(NEW.round_id, OLD.round_id);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Touches the parent game of a changed round, player or asterisk, bumping its version
+CREATE OR REPLACE FUNCTION trigger_touch_game()
+RETURNS TRIGGER AS $$
+BEGIN
+  UPDATE games SET updated_at = NOW() WHERE game_id = COALESCE(NEW.game_id, OLD.game_id);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER bump_version_games
+BEFORE UPDATE ON games
+FOR EACH ROW
+EXECUTE FUNCTION trigger_bump_version();
+
+CREATE TRIGGER bump_version_rounds
+BEFORE UPDATE ON rounds
+FOR EACH ROW
+EXECUTE FUNCTION trigger_bump_version();
+
+CREATE TRIGGER touch_round_player_round_scores
+AFTER INSERT OR UPDATE OR DELETE ON player_round_scores
+FOR EACH ROW
+EXECUTE FUNCTION trigger_touch_round();
+
+CREATE TRIGGER touch_round_round_bonus_events
+AFTER INSERT OR DELETE ON round_bonus_events
+FOR EACH ROW
+EXECUTE FUNCTION trigger_touch_round();
+
+CREATE TRIGGER touch_game_rounds
+AFTER INSERT OR UPDATE OR DELETE ON rounds
+FOR EACH ROW
+EXECUTE FUNCTION trigger_touch_game();
+
+CREATE TRIGGER touch_game_game_players
+AFTER INSERT OR UPDATE OR DELETE ON game_players
+FOR EACH ROW
+EXECUTE FUNCTION trigger_touch_game();
+
+CREATE TRIGGER touch_game_player_game_asterisks
+AFTER INSERT OR DELETE ON player_game_asterisks
+FOR EACH ROW
+EXECUTE FUNCTION trigger_touch_game();
+
+-- Functions to record score changes in the audit log
+-- Records a single field change, skipping unchanged values and changes cascading from a deleted
+-- game. The acting user is read from the 'skullking.acting_user_id' setting the application sets
+-- on the transaction.
+CREATE OR REPLACE FUNCTION log_score_change(
+  p_game_id UUID,
+  p_round_id UUID,
+  p_game_player_id UUID,
+  p_table_name TEXT,
+  p_operation TEXT,
+  p_field_name TEXT,
+  p_old_value TEXT,
+  p_new_value TEXT
+)
+RETURNS VOID AS $$
+BEGIN
+  IF p_old_value IS NOT DISTINCT FROM p_new_value THEN
+    RETURN;
+  END IF;
+  IF NOT EXISTS (SELECT 1 FROM games WHERE game_id = p_game_id) THEN
+    RETURN;
+  END IF;
+
+  INSERT INTO score_audit_log (
+    game_id, round_id, game_player_id, table_name, operation,
+    field_name, old_value, new_value, changed_by_user_id
+  )
+  VALUES (
+    p_game_id, p_round_id, p_game_player_id, p_table_name, p_operation,
+    p_field_name, p_old_value, p_new_value,
+    NULLIF(current_setting('skullking.acting_user_id', true), '')::UUID
+  );
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION trigger_audit_rounds()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' THEN
+    PERFORM log_score_change(NEW.game_id, NEW.round_id, NULL, TG_TABLE_NAME, TG_OP, 'status', NULL, NEW.status);
+  ELSIF TG_OP = 'UPDATE' THEN
+    PERFORM log_score_change(NEW.game_id, NEW.round_id, NULL, TG_TABLE_NAME, TG_OP, 'status', OLD.status, NEW.status);
+    PERFORM log_score_change(
+      NEW.game_id, NEW.round_id, NULL, TG_TABLE_NAME, TG_OP, 'dealer_game_player_id',
+      OLD.dealer_game_player_id::TEXT, NEW.dealer_game_player_id::TEXT
+    );
+    PERFORM log_score_change(
+      NEW.game_id, NEW.round_id, NULL, TG_TABLE_NAME, TG_OP, 'kraken_discarded_tricks',
+      OLD.kraken_discarded_tricks::TEXT, NEW.kraken_discarded_tricks::TEXT
+    );
+  ELSE
+    PERFORM log_score_change(OLD.game_id, OLD.round_id, NULL, TG_TABLE_NAME, TG_OP, 'status', OLD.status, NULL);
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION trigger_audit_player_round_scores()
+RETURNS TRIGGER AS $$
+DECLARE
+  v_game_id UUID;
+BEGIN
+  SELECT game_id INTO v_game_id
+  FROM game_players
+  WHERE game_player_id = COALESCE(NEW.game_player_id, OLD.game_player_id);
+
+  IF TG_OP = 'INSERT' THEN
+    PERFORM log_score_change(
+      v_game_id, NEW.round_id, NEW.game_player_id, TG_TABLE_NAME, TG_OP, 'bid_amount',
+      NULL, NEW.bid_amount::TEXT
+    );
+  ELSIF TG_OP = 'UPDATE' THEN
+    PERFORM log_score_change(
+      v_game_id, NEW.round_id, NEW.game_player_id, TG_TABLE_NAME, TG_OP, 'bid_amount',
+      OLD.bid_amount::TEXT, NEW.bid_amount::TEXT
+    );
+    PERFORM log_score_change(
+      v_game_id, NEW.round_id, NEW.game_player_id, TG_TABLE_NAME, TG_OP, 'tricks_taken',
+      OLD.tricks_taken::TEXT, NEW.tricks_taken::TEXT
+    );
+    PERFORM log_score_change(
+      v_game_id, NEW.round_id, NEW.game_player_id, TG_TABLE_NAME, TG_OP, 'bonus_points_applied',
+      OLD.bonus_points_applied::TEXT, NEW.bonus_points_applied::TEXT
+    );
+    PERFORM log_score_change(
+      v_game_id, NEW.round_id, NEW.game_player_id, TG_TABLE_NAME, TG_OP, 'round_score',
+      OLD.round_score::TEXT, NEW.round_score::TEXT
+    );
+  ELSE
+    PERFORM log_score_change(
+      v_game_id, OLD.round_id, OLD.game_player_id, TG_TABLE_NAME, TG_OP, 'bid_amount',
+      OLD.bid_amount::TEXT, NULL
+    );
+    PERFORM log_score_change(
+      v_game_id, OLD.round_id, OLD.game_player_id, TG_TABLE_NAME, TG_OP, 'tricks_taken',
+      OLD.tricks_taken::TEXT, NULL
+    );
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_rounds
+AFTER INSERT OR UPDATE OR DELETE ON rounds
+FOR EACH ROW
+EXECUTE FUNCTION trigger_audit_rounds();
+
+CREATE TRIGGER audit_player_round_scores
+AFTER INSERT OR UPDATE OR DELETE ON player_round_scores
+FOR EACH ROW
+EXECUTE FUNCTION trigger_audit_player_round_scores();
+
 -- Indexes
 CREATE INDEX idx_user_provider_identities_user_id ON user_provider_identities(user_id);
 CREATE INDEX idx_user_provider_identities_provider_lookup ON user_provider_identities(provider_name, provider_user_id);
@@ -203,6 +497,7 @@ CREATE INDEX idx_game_sessions_created_by_user_id ON game_sessions(created_by_us
 
 CREATE INDEX idx_games_session_id ON games(session_id);
 CREATE INDEX idx_games_created_by_user_id ON games(created_by_user_id);
+CREATE INDEX idx_games_ruleset ON games(ruleset);
 CREATE INDEX idx_games_starting_dealer_game_player_id ON games(starting_dealer_game_player_id);
 
 CREATE INDEX idx_game_players_game_id ON game_players(game_id);
@@ -215,6 +510,30 @@ CREATE INDEX idx_rounds_dealer_game_player_id ON rounds(dealer_game_player_id);
 CREATE INDEX idx_player_round_scores_round_id ON player_round_scores(round_id);
 CREATE INDEX idx_player_round_scores_game_player_id ON player_round_scores(game_player_id);
 
+CREATE INDEX idx_round_bonus_events_round_id ON round_bonus_events(round_id);
+CREATE INDEX idx_round_bonus_events_game_player_id ON round_bonus_events(game_player_id);
+CREATE INDEX idx_round_bonus_events_ally_game_player_id ON round_bonus_events(ally_game_player_id);
+
+CREATE INDEX idx_score_audit_log_game_id ON score_audit_log(game_id, changed_at);
+CREATE INDEX idx_score_audit_log_round_id ON score_audit_log(round_id);
+CREATE INDEX idx_score_audit_log_game_player_id ON score_audit_log(game_player_id);
+CREATE INDEX idx_score_audit_log_changed_by_user_id ON score_audit_log(changed_by_user_id);
+
+CREATE INDEX idx_scorekeeper_handoffs_game_id ON scorekeeper_handoffs(game_id, created_at);
+CREATE INDEX idx_scorekeeper_handoffs_from_user_id ON scorekeeper_handoffs(from_user_id);
+CREATE INDEX idx_scorekeeper_handoffs_to_user_id ON scorekeeper_handoffs(to_user_id);
+-- A player can only have one open request for control of a game
+CREATE UNIQUE INDEX uq_scorekeeper_handoffs_pending_request ON scorekeeper_handoffs(game_id, to_user_id)
+WHERE status = 'pending';
+
+CREATE INDEX idx_sync_actions_applied_by_user_id ON sync_actions(applied_by_user_id);
+
+CREATE INDEX idx_game_join_codes_created_by_user_id ON game_join_codes(created_by_user_id);
+
+CREATE INDEX idx_share_links_game_id ON share_links(game_id);
+CREATE INDEX idx_share_links_session_id ON share_links(session_id);
+CREATE INDEX idx_share_links_created_by_user_id ON share_links(created_by_user_id);
+
 CREATE INDEX idx_player_game_asterisks_game_player_id ON player_game_asterisks(game_player_id);
 CREATE INDEX idx_player_game_asterisks_game_id ON player_game_asterisks(game_id);
 